@@ -4,6 +4,7 @@ type Type struct {
 	NamedType string
 	ListType  *Type
 	NotNull   bool
+	Position  *Position
 }
 
 type DirectiveDefinition struct {
@@ -12,24 +13,29 @@ type DirectiveDefinition struct {
 	ArgumentsDefinition []InputValueDefinition
 	IsRepeatable        bool
 	DirectiveLocations  []DirectiveLocation
+	Position            *Position
 }
 
 type InputValueDefinition struct {
 	Description     string
 	Name            string
 	Type            Type
+	DefaultValue    Value
 	RawDefaultValue string
 	Directives      []Directive
+	Position        *Position
 }
 
 type Directive struct {
 	Name      string
 	Arguments []Argument
+	Position  *Position
 }
 
 type Argument struct {
-	Name  string
-	Value string
+	Name     string
+	Value    Value
+	Position *Position
 }
 
 type DirectiveLocation int
@@ -60,3 +66,50 @@ const (
 	DirectiveLocationInlineFragment
 	DirectiveLocationVariableDefinition
 )
+
+// String returns the location as written in a directive definition, e.g. "FIELD_DEFINITION".
+func (l DirectiveLocation) String() string {
+	switch l {
+	case DirectiveLocationSchema:
+		return "SCHEMA"
+	case DirectiveLocationScalar:
+		return "SCALAR"
+	case DirectiveLocationObject:
+		return "OBJECT"
+	case DirectiveLocationFieldDefinition:
+		return "FIELD_DEFINITION"
+	case DirectiveLocationArgumentDefinition:
+		return "ARGUMENT_DEFINITION"
+	case DirectiveLocationInterface:
+		return "INTERFACE"
+	case DirectiveLocationUnion:
+		return "UNION"
+	case DirectiveLocationEnum:
+		return "ENUM"
+	case DirectiveLocationEnumValue:
+		return "ENUM_VALUE"
+	case DirectiveLocationInputObject:
+		return "INPUT_OBJECT"
+	case DirectiveLocationInputFieldDefinition:
+		return "INPUT_FIELD_DEFINITION"
+
+	case DirectiveLocationQuery:
+		return "QUERY"
+	case DirectiveLocationMutation:
+		return "MUTATION"
+	case DirectiveLocationSubscription:
+		return "SUBSCRIPTION"
+	case DirectiveLocationField:
+		return "FIELD"
+	case DirectiveLocationFragmentDefinition:
+		return "FRAGMENT_DEFINITION"
+	case DirectiveLocationFragmentSpread:
+		return "FRAGMENT_SPREAD"
+	case DirectiveLocationInlineFragment:
+		return "INLINE_FRAGMENT"
+	case DirectiveLocationVariableDefinition:
+		return "VARIABLE_DEFINITION"
+	default:
+		return "UNKNOWN"
+	}
+}
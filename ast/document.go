@@ -30,6 +30,12 @@ func (d *TypeSystemExtensionDocument) Merge(others ...*TypeSystemExtensionDocume
 	return
 }
 
+type ExecutableDocument struct {
+	OperationDefinitions []*OperationDefinition
+	FragmentDefinitions  []*FragmentDefinition
+}
+
 type RootOperationTypeDefinition struct {
-	Type string
+	Type     string
+	Position *Position
 }
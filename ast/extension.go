@@ -13,72 +13,129 @@ const (
 
 type TypeSystemExtension interface {
 	TypeSystemExtensionKind() TypeSystemExtensionKind
+	TypeName() string
+	GetPosition() *Position
 }
 
 type ScalarTypeExtension struct {
 	Name       string
 	Directives []Directive
+	Position   *Position
 }
 
 func (e *ScalarTypeExtension) TypeSystemExtensionKind() TypeSystemExtensionKind {
 	return TypeSystemExtensionKindScalar
 }
 
+func (e *ScalarTypeExtension) TypeName() string {
+	return e.Name
+}
+
+func (e *ScalarTypeExtension) GetPosition() *Position {
+	return e.Position
+}
+
 type ObjectTypeExtension struct {
 	Name                string
 	Directives          []Directive
 	FieldsDefinition    []*FieldDefinition
 	ImplementInterfaces []string
+	Position            *Position
 }
 
 func (e *ObjectTypeExtension) TypeSystemExtensionKind() TypeSystemExtensionKind {
 	return TypeSystemExtensionKindObject
 }
 
+func (e *ObjectTypeExtension) TypeName() string {
+	return e.Name
+}
+
+func (e *ObjectTypeExtension) GetPosition() *Position {
+	return e.Position
+}
+
 type InterfaceTypeExtension struct {
 	Name                string
 	ImplementInterfaces []string
 	Directives          []Directive
 	FieldsDefinition    []*FieldDefinition
+	Position            *Position
 }
 
 func (e *InterfaceTypeExtension) TypeSystemExtensionKind() TypeSystemExtensionKind {
 	return TypeSystemExtensionKindInterface
 }
 
+func (e *InterfaceTypeExtension) TypeName() string {
+	return e.Name
+}
+
+func (e *InterfaceTypeExtension) GetPosition() *Position {
+	return e.Position
+}
+
 type UnionTypeExtension struct {
 	Name        string
 	Directives  []Directive
 	MemberTypes []Type
+	Position    *Position
 }
 
 func (e *UnionTypeExtension) TypeSystemExtensionKind() TypeSystemExtensionKind {
 	return TypeSystemExtensionKindUnion
 }
 
+func (e *UnionTypeExtension) TypeName() string {
+	return e.Name
+}
+
+func (e *UnionTypeExtension) GetPosition() *Position {
+	return e.Position
+}
+
 type EnumTypeExtension struct {
 	Name       string
 	Directives []Directive
 	EnumValue  []EnumValueDefinition
+	Position   *Position
 }
 
 func (e *EnumTypeExtension) TypeSystemExtensionKind() TypeSystemExtensionKind {
 	return TypeSystemExtensionKindEnum
 }
 
+func (e *EnumTypeExtension) TypeName() string {
+	return e.Name
+}
+
+func (e *EnumTypeExtension) GetPosition() *Position {
+	return e.Position
+}
+
 type InputObjectTypeExtension struct {
 	Name                  string
 	Directives            []Directive
 	InputsFieldDefinition []InputValueDefinition
+	Position              *Position
 }
 
 func (e *InputObjectTypeExtension) TypeSystemExtensionKind() TypeSystemExtensionKind {
 	return TypeSystemExtensionKindInputObject
 }
 
+func (e *InputObjectTypeExtension) TypeName() string {
+	return e.Name
+}
+
+func (e *InputObjectTypeExtension) GetPosition() *Position {
+	return e.Position
+}
+
 type SchemaExtension struct {
 	Directives   []Directive
 	Query        *RootOperationTypeDefinition
 	Mutation     *RootOperationTypeDefinition
 	Subscription *RootOperationTypeDefinition
+	Position     *Position
 }
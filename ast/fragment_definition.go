@@ -0,0 +1,11 @@
+package ast
+
+// https://spec.graphql.org/October2021/#sec-Language.Fragments
+
+type FragmentDefinition struct {
+	Name          string
+	TypeCondition string
+	Directives    []Directive
+	SelectionSet  SelectionSet
+	Position      *Position
+}
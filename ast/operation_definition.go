@@ -0,0 +1,43 @@
+package ast
+
+// https://spec.graphql.org/October2021/#sec-Language.Operations
+
+type OperationType int
+
+const (
+	OperationTypeQuery OperationType = iota
+	OperationTypeMutation
+	OperationTypeSubscription
+)
+
+// String returns the operation type as written in a document, e.g. "query".
+func (t OperationType) String() string {
+	switch t {
+	case OperationTypeQuery:
+		return "query"
+	case OperationTypeMutation:
+		return "mutation"
+	case OperationTypeSubscription:
+		return "subscription"
+	default:
+		return "unknown"
+	}
+}
+
+type OperationDefinition struct {
+	OperationType       OperationType
+	Name                string
+	VariableDefinitions []VariableDefinition
+	Directives          []Directive
+	SelectionSet        SelectionSet
+	Position            *Position
+}
+
+type VariableDefinition struct {
+	Variable        string
+	Type            Type
+	DefaultValue    Value
+	RawDefaultValue string
+	Directives      []Directive
+	Position        *Position
+}
@@ -0,0 +1,21 @@
+package ast
+
+import "fmt"
+
+// Position is the location of a node in its source.
+// Start and End are byte offsets into Source.Body, and Line and Column are 1-based.
+type Position struct {
+	Source *Source
+	Start  int
+	End    int
+	Line   int
+	Column int
+}
+
+// String returns the position as "name:line:column", or "line:column" if the source has no name.
+func (p *Position) String() string {
+	if p.Source != nil && p.Source.Name != "" {
+		return fmt.Sprintf("%s:%d:%d", p.Source.Name, p.Line, p.Column)
+	}
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
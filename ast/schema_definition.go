@@ -6,4 +6,5 @@ type SchemaDefinition struct {
 	Query        *RootOperationTypeDefinition
 	Mutation     *RootOperationTypeDefinition
 	Subscription *RootOperationTypeDefinition
+	Position     *Position
 }
@@ -0,0 +1,59 @@
+package ast
+
+// https://spec.graphql.org/October2021/#sec-Selection-Sets
+
+type SelectionKind int
+
+const (
+	SelectionKindField SelectionKind = iota
+	SelectionKindFragmentSpread
+	SelectionKindInlineFragment
+)
+
+type Selection interface {
+	SelectionKind() SelectionKind
+}
+
+type SelectionSet []Selection
+
+type Field struct {
+	Alias        string
+	Name         string
+	Arguments    []Argument
+	Directives   []Directive
+	SelectionSet SelectionSet
+	Position     *Position
+}
+
+func (f *Field) SelectionKind() SelectionKind {
+	return SelectionKindField
+}
+
+// ResponseKey returns the key under which the field appears in a response.
+func (f *Field) ResponseKey() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+type FragmentSpread struct {
+	FragmentName string
+	Directives   []Directive
+	Position     *Position
+}
+
+func (s *FragmentSpread) SelectionKind() SelectionKind {
+	return SelectionKindFragmentSpread
+}
+
+type InlineFragment struct {
+	TypeCondition string
+	Directives    []Directive
+	SelectionSet  SelectionSet
+	Position      *Position
+}
+
+func (f *InlineFragment) SelectionKind() SelectionKind {
+	return SelectionKindInlineFragment
+}
@@ -13,16 +13,38 @@ const (
 	TypeDefinitionKindInputObject
 )
 
+// String returns the kind in prose, e.g. "input object".
+func (k TypeDefinitionKind) String() string {
+	switch k {
+	case TypeDefinitionKindScalar:
+		return "scalar"
+	case TypeDefinitionKindObject:
+		return "object"
+	case TypeDefinitionKindInterface:
+		return "interface"
+	case TypeDefinitionKindUnion:
+		return "union"
+	case TypeDefinitionKindEnum:
+		return "enum"
+	case TypeDefinitionKindInputObject:
+		return "input object"
+	default:
+		return "unknown"
+	}
+}
+
 type TypeDefinition interface {
 	TypeDefinitionKind() TypeDefinitionKind
 	TypeName() string
 	GetDirectives() []Directive
+	GetPosition() *Position
 }
 
 type ScalarTypeDefinition struct {
 	Description string
 	Name        string
 	Directives  []Directive
+	Position    *Position
 }
 
 func (d *ScalarTypeDefinition) TypeDefinitionKind() TypeDefinitionKind {
@@ -37,12 +59,17 @@ func (d *ScalarTypeDefinition) GetDirectives() []Directive {
 	return d.Directives
 }
 
+func (d *ScalarTypeDefinition) GetPosition() *Position {
+	return d.Position
+}
+
 type FieldDefinition struct {
 	Description        string
 	Name               string
 	ArgumentDefinition []InputValueDefinition
 	Type               Type
 	Directives         []Directive
+	Position           *Position
 }
 
 type ObjectTypeDefinition struct {
@@ -51,6 +78,7 @@ type ObjectTypeDefinition struct {
 	Directives       []Directive
 	FieldDefinitions []*FieldDefinition
 	Interfaces       []string
+	Position         *Position
 }
 
 func (d *ObjectTypeDefinition) TypeDefinitionKind() TypeDefinitionKind {
@@ -65,12 +93,17 @@ func (d *ObjectTypeDefinition) GetDirectives() []Directive {
 	return d.Directives
 }
 
+func (d *ObjectTypeDefinition) GetPosition() *Position {
+	return d.Position
+}
+
 type InterfaceTypeDefinition struct {
 	Description      string
 	Name             string
 	Directives       []Directive
 	FieldDefinitions []*FieldDefinition
 	Interfaces       []string
+	Position         *Position
 }
 
 func (d *InterfaceTypeDefinition) TypeDefinitionKind() TypeDefinitionKind {
@@ -85,11 +118,16 @@ func (d *InterfaceTypeDefinition) GetDirectives() []Directive {
 	return d.Directives
 }
 
+func (d *InterfaceTypeDefinition) GetPosition() *Position {
+	return d.Position
+}
+
 type UnionTypeDefinition struct {
 	Description string
 	Name        string
 	Directives  []Directive
 	MemberTypes []Type
+	Position    *Position
 }
 
 func (d *UnionTypeDefinition) TypeDefinitionKind() TypeDefinitionKind {
@@ -104,17 +142,23 @@ func (d *UnionTypeDefinition) GetDirectives() []Directive {
 	return d.Directives
 }
 
+func (d *UnionTypeDefinition) GetPosition() *Position {
+	return d.Position
+}
+
 type EnumTypeDefinition struct {
 	Description string
 	Name        string
 	Directives  []Directive
 	EnumValue   []EnumValueDefinition
+	Position    *Position
 }
 
 type EnumValueDefinition struct {
 	Description string
 	Value       EnumValue
 	Directives  []Directive
+	Position    *Position
 }
 
 func (d *EnumTypeDefinition) TypeDefinitionKind() TypeDefinitionKind {
@@ -129,11 +173,16 @@ func (d *EnumTypeDefinition) GetDirectives() []Directive {
 	return d.Directives
 }
 
+func (d *EnumTypeDefinition) GetPosition() *Position {
+	return d.Position
+}
+
 type InputObjectTypeDefinition struct {
 	Description string
 	Name        string
 	Directives  []Directive
 	InputFields []InputValueDefinition
+	Position    *Position
 }
 
 func (d *InputObjectTypeDefinition) TypeDefinitionKind() TypeDefinitionKind {
@@ -147,3 +196,7 @@ func (d *InputObjectTypeDefinition) TypeName() string {
 func (d *InputObjectTypeDefinition) GetDirectives() []Directive {
 	return d.Directives
 }
+
+func (d *InputObjectTypeDefinition) GetPosition() *Position {
+	return d.Position
+}
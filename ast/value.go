@@ -18,8 +18,18 @@ type Value interface {
 	ValueKind() ValueKind
 }
 
+type Variable struct {
+	Name     string
+	Position *Position
+}
+
+func (v Variable) ValueKind() ValueKind {
+	return ValueKindVariable
+}
+
 type IntValue struct {
-	Value int64
+	Value    int64
+	Position *Position
 }
 
 func (i IntValue) ValueKind() ValueKind {
@@ -27,7 +37,8 @@ func (i IntValue) ValueKind() ValueKind {
 }
 
 type FloatValue struct {
-	Value float64
+	Value    float64
+	Position *Position
 }
 
 func (f FloatValue) ValueKind() ValueKind {
@@ -35,7 +46,8 @@ func (f FloatValue) ValueKind() ValueKind {
 }
 
 type StringValue struct {
-	Value string
+	Value    string
+	Position *Position
 }
 
 func (s StringValue) ValueKind() ValueKind {
@@ -43,21 +55,25 @@ func (s StringValue) ValueKind() ValueKind {
 }
 
 type BooleanValue struct {
-	Value bool
+	Value    bool
+	Position *Position
 }
 
 func (b BooleanValue) ValueKind() ValueKind {
 	return ValueKindBoolean
 }
 
-type NullValue struct{}
+type NullValue struct {
+	Position *Position
+}
 
 func (n NullValue) ValueKind() ValueKind {
 	return ValueKindNull
 }
 
 type EnumValue struct {
-	Value string
+	Value    string
+	Position *Position
 }
 
 func (e EnumValue) ValueKind() ValueKind {
@@ -65,7 +81,8 @@ func (e EnumValue) ValueKind() ValueKind {
 }
 
 type ListValue struct {
-	Values []Value
+	Values   []Value
+	Position *Position
 }
 
 func (l ListValue) ValueKind() ValueKind {
@@ -73,7 +90,8 @@ func (l ListValue) ValueKind() ValueKind {
 }
 
 type ObjectValue struct {
-	Fields []ObjectField
+	Fields   []ObjectField
+	Position *Position
 }
 
 func (o ObjectValue) ValueKind() ValueKind {
@@ -81,6 +99,7 @@ func (o ObjectValue) ValueKind() ValueKind {
 }
 
 type ObjectField struct {
-	Name  string
-	Value Value
+	Name     string
+	Value    Value
+	Position *Position
 }
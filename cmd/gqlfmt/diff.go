@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+)
+
+type editOp int
+
+const (
+	editEqual editOp = iota
+	editDelete
+	editInsert
+)
+
+type edit struct {
+	op   editOp
+	line string
+}
+
+// diffContext is the number of unchanged lines printed around a change.
+const diffContext = 3
+
+// unifiedDiff returns the changes from a to b in unified format, or nil if they are equal.
+func unifiedDiff(nameA, nameB string, a, b []byte) []byte {
+	edits := diffLines(splitLines(a), splitLines(b))
+
+	var changes []int
+	for i, e := range edits {
+		if e.op != editEqual {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", nameA, nameB)
+
+	// lineA and lineB count the lines of a and b before edits[i].
+	lineA, lineB, i := 0, 0, 0
+	for c := 0; c < len(changes); {
+		start := changes[c] - diffContext
+		if start < i {
+			start = i
+		}
+
+		// a hunk takes every change whose context touches the context of the previous one.
+		last := c
+		for last+1 < len(changes) && changes[last+1]-changes[last] <= 2*diffContext+1 {
+			last++
+		}
+		end := changes[last] + diffContext + 1
+		if end > len(edits) {
+			end = len(edits)
+		}
+
+		for ; i < start; i++ {
+			lineA++
+			lineB++
+		}
+
+		var hunk bytes.Buffer
+		countA, countB := 0, 0
+		for _, e := range edits[start:end] {
+			switch e.op {
+			case editEqual:
+				hunk.WriteByte(' ')
+				countA++
+				countB++
+			case editDelete:
+				hunk.WriteByte('-')
+				countA++
+			case editInsert:
+				hunk.WriteByte('+')
+				countB++
+			}
+			hunk.WriteString(e.line)
+			if len(e.line) == 0 || e.line[len(e.line)-1] != '\n' {
+				hunk.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		fmt.Fprintf(&buf, "@@ -%s +%s @@\n", hunkRange(lineA, countA), hunkRange(lineB, countB))
+		buf.Write(hunk.Bytes())
+
+		lineA += countA
+		lineB += countB
+		i = end
+		c = last + 1
+	}
+
+	return buf.Bytes()
+}
+
+// hunkRange formats the lines after the first before lines of a hunk header.
+func hunkRange(before, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", before)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", before+1)
+	}
+	return fmt.Sprintf("%d,%d", before+1, count)
+}
+
+// splitLines splits s after each newline. The last line has no newline if s does not end with one.
+func splitLines(s []byte) []string {
+	var lines []string
+	for len(s) > 0 {
+		i := bytes.IndexByte(s, '\n')
+		if i < 0 {
+			lines = append(lines, string(s))
+			break
+		}
+		lines = append(lines, string(s[:i+1]))
+		s = s[i+1:]
+	}
+	return lines
+}
+
+// diffLines returns the shortest edit script from a to b with the Myers algorithm.
+//
+// Reference: "An O(ND) Difference Algorithm and Its Variations", Eugene W. Myers
+func diffLines(a, b []string) []edit {
+	n, m := len(a), len(b)
+	maxD := n + m
+	offset := maxD + 1
+	v := make([]int, 2*offset+1)
+
+	// trace[d] holds v[-d-1:d+2] as it was before step d.
+	var trace [][]int
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(trace, a, b)
+			}
+		}
+	}
+	return nil
+}
+
+func backtrack(trace [][]int, a, b []string) []edit {
+	var edits []edit
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := func(k int) int { return trace[d][k+d+1] }
+
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && v(k-1) < v(k+1)) {
+			prevK = k + 1
+		}
+		prevX := v(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			edits = append(edits, edit{op: editEqual, line: a[x]})
+		}
+		if d > 0 {
+			if x == prevX {
+				y--
+				edits = append(edits, edit{op: editInsert, line: b[y]})
+			} else {
+				x--
+				edits = append(edits, edit{op: editDelete, line: a[x]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+	return edits
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	lines := func(from, to int) string {
+		var b strings.Builder
+		for i := from; i <= to; i++ {
+			b.WriteString(string(rune('a'+i-1)) + "\n")
+		}
+		return b.String()
+	}
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{
+			name: "equal",
+			a:    lines(1, 3),
+			b:    lines(1, 3),
+			want: "",
+		},
+		{
+			name: "change in the middle",
+			a:    lines(1, 9),
+			b:    lines(1, 4) + "E\n" + lines(6, 9),
+			want: `--- a
++++ b
+@@ -2,7 +2,7 @@
+ b
+ c
+ d
+-e
++E
+ f
+ g
+ h
+`,
+		},
+		{
+			name: "distant changes make two hunks",
+			a:    lines(1, 12),
+			b:    "A\n" + lines(2, 11) + "L\n",
+			want: `--- a
++++ b
+@@ -1,4 +1,4 @@
+-a
++A
+ b
+ c
+ d
+@@ -9,4 +9,4 @@
+ i
+ j
+ k
+-l
++L
+`,
+		},
+		{
+			name: "insertion into empty file",
+			a:    "",
+			b:    lines(1, 2),
+			want: `--- a
++++ b
+@@ -0,0 +1,2 @@
++a
++b
+`,
+		},
+		{
+			name: "missing newline at end of file",
+			a:    "a\nb",
+			b:    "a\nb\n",
+			want: `--- a
++++ b
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++b
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(unifiedDiff("a", "b", []byte(tt.a), []byte(tt.b)))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unifiedDiff() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+// Gqlfmt formats GraphQL schema files.
+//
+// Usage:
+//
+//	gqlfmt [flags] [path ...]
+//
+// Without a path, gqlfmt formats standard input to standard output.
+// Given a directory, it formats every .graphql and .graphqls file in it, recursively.
+// Files are rewritten in place unless -l, -check or -diff is given.
+//
+// The flags are:
+//
+//	-l
+//		List files whose formatting differs from gqlfmt's.
+//	-check
+//		Exit with status 1 if a file is not formatted.
+//	-diff
+//		Print a unified diff of the changes.
+//	-sort
+//		Sort definitions by kind and name instead of keeping the source order.
+//
+// Comments are not kept by the parser, so files with comments are reported as errors and left untouched.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/parser"
+	"github.com/Sntree2mi8/gogqlparser/printer"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+type formatter struct {
+	list  bool
+	check bool
+	diff  bool
+	cfg   printer.Config
+
+	stdout io.Writer
+	stderr io.Writer
+
+	// unformatted is set when a file differs from its formatted content.
+	unformatted bool
+	// failed is set when a file can not be formatted.
+	failed bool
+}
+
+// run executes gqlfmt with args and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("gqlfmt", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: gqlfmt [flags] [path ...]")
+		flags.PrintDefaults()
+	}
+
+	f := &formatter{stdout: stdout, stderr: stderr}
+	var sortDefinitions bool
+	flags.BoolVar(&f.list, "l", false, "list files whose formatting differs from gqlfmt's")
+	flags.BoolVar(&f.check, "check", false, "exit with status 1 if a file is not formatted")
+	flags.BoolVar(&f.diff, "diff", false, "print a unified diff of the changes")
+	flags.BoolVar(&sortDefinitions, "sort", false, "sort definitions by kind and name")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if sortDefinitions {
+		f.cfg.Order = printer.OrderSorted
+	}
+
+	if flags.NArg() == 0 {
+		f.formatFile("<standard input>", stdin, true)
+	}
+	for _, path := range flags.Args() {
+		info, err := os.Stat(path)
+		if err != nil {
+			f.report(err)
+			continue
+		}
+		if info.IsDir() {
+			f.formatDir(path)
+			continue
+		}
+		f.formatPath(path)
+	}
+
+	switch {
+	case f.failed:
+		return 2
+	case f.check && f.unformatted:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (f *formatter) report(err error) {
+	fmt.Fprintln(f.stderr, err)
+	f.failed = true
+}
+
+func (f *formatter) formatDir(dir string) {
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			f.report(err)
+			return nil
+		}
+		if !d.IsDir() && isGraphQLFile(path) {
+			f.formatPath(path)
+		}
+		return nil
+	})
+	if err != nil {
+		f.report(err)
+	}
+}
+
+func isGraphQLFile(path string) bool {
+	switch filepath.Ext(path) {
+	case ".graphql", ".graphqls":
+		return true
+	default:
+		return false
+	}
+}
+
+func (f *formatter) formatPath(path string) {
+	file, err := os.Open(path)
+	if err != nil {
+		f.report(err)
+		return
+	}
+	defer file.Close()
+
+	f.formatFile(path, file, false)
+}
+
+// formatFile formats the content of in named name.
+// standard input is written back to standard output instead of the file.
+func (f *formatter) formatFile(name string, in io.Reader, isStdin bool) {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		f.report(err)
+		return
+	}
+
+	res, err := format(name, src, &f.cfg)
+	if err != nil {
+		f.report(err)
+		return
+	}
+
+	rewrite := !f.list && !f.check && !f.diff
+	if !bytes.Equal(src, res) {
+		f.unformatted = true
+		if f.list {
+			fmt.Fprintln(f.stdout, name)
+		}
+		if f.diff {
+			f.stdout.Write(unifiedDiff(name+".orig", name, src, res))
+		}
+		if rewrite && !isStdin {
+			if err = writeFile(name, res); err != nil {
+				f.report(err)
+			}
+		}
+	}
+	if rewrite && isStdin {
+		f.stdout.Write(res)
+	}
+}
+
+// writeFile replaces the content of the file at path, keeping its permissions.
+func writeFile(path string, data []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, info.Mode().Perm())
+}
+
+// format returns src printed in canonical style.
+func format(name string, src []byte, cfg *printer.Config) ([]byte, error) {
+	if hasComment(src) {
+		return nil, fmt.Errorf("%s: %w", name, errComment)
+	}
+
+	doc, err := parser.ParseTypeSystemExtensionDocument(&ast.Source{Name: name, Body: string(src)})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err = cfg.Fprint(&buf, doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+var errComment = errors.New("comments would be dropped, format the file by hand")
+
+// hasComment reports whether src holds a comment, skipping '#' inside strings.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Comments
+func hasComment(src []byte) bool {
+	for i := 0; i < len(src); i++ {
+		switch {
+		case src[i] == '#':
+			return true
+		case bytes.HasPrefix(src[i:], []byte(`"""`)):
+			i += 3
+			for i < len(src) && !bytes.HasPrefix(src[i:], []byte(`"""`)) {
+				if bytes.HasPrefix(src[i:], []byte(`\"""`)) {
+					i += 3
+				}
+				i++
+			}
+			i += 2
+		case src[i] == '"':
+			i++
+			for i < len(src) && src[i] != '"' && src[i] != '\n' {
+				if src[i] == '\\' {
+					i++
+				}
+				i++
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	unformatted = "type Query {a: Int\n  b: String}\n"
+	formatted   = "type Query {\n  a: Int\n  b: String\n}\n"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		files      map[string]string
+		wantStatus int
+		wantStdout string
+		wantStderr string
+		wantFiles  map[string]string
+	}{
+		{
+			name:       "rewrite files in directory",
+			files:      map[string]string{"a.graphql": unformatted, "sub/b.graphqls": unformatted, "c.txt": unformatted},
+			wantStatus: 0,
+			wantFiles:  map[string]string{"a.graphql": formatted, "sub/b.graphqls": formatted, "c.txt": unformatted},
+		},
+		{
+			name:       "list",
+			args:       []string{"-l"},
+			files:      map[string]string{"a.graphql": unformatted, "b.graphql": formatted},
+			wantStatus: 0,
+			wantStdout: "a.graphql\n",
+			wantFiles:  map[string]string{"a.graphql": unformatted, "b.graphql": formatted},
+		},
+		{
+			name:       "check unformatted",
+			args:       []string{"-check"},
+			files:      map[string]string{"a.graphql": unformatted},
+			wantStatus: 1,
+			wantFiles:  map[string]string{"a.graphql": unformatted},
+		},
+		{
+			name:       "check formatted",
+			args:       []string{"--check"},
+			files:      map[string]string{"a.graphql": formatted},
+			wantStatus: 0,
+			wantFiles:  map[string]string{"a.graphql": formatted},
+		},
+		{
+			name:       "diff",
+			args:       []string{"--diff"},
+			files:      map[string]string{"a.graphql": unformatted},
+			wantStatus: 0,
+			wantStdout: "--- a.graphql.orig\n+++ a.graphql\n@@ -1,2 +1,4 @@\n-type Query {a: Int\n-  b: String}\n+type Query {\n+  a: Int\n+  b: String\n+}\n",
+			wantFiles:  map[string]string{"a.graphql": unformatted},
+		},
+		{
+			name:       "sort",
+			args:       []string{"-sort"},
+			files:      map[string]string{"a.graphql": "scalar B\nscalar A\n"},
+			wantStatus: 0,
+			wantFiles:  map[string]string{"a.graphql": "scalar A\n\nscalar B\n"},
+		},
+		{
+			name:       "comments are not dropped",
+			files:      map[string]string{"a.graphql": "# comment\n" + unformatted, "b.graphql": "\"not # a comment\"\n" + unformatted},
+			wantStatus: 2,
+			wantStderr: "a.graphql: comments would be dropped, format the file by hand\n",
+			wantFiles:  map[string]string{"a.graphql": "# comment\n" + unformatted, "b.graphql": "\"not # a comment\"\ntype Query {\n  a: Int\n  b: String\n}\n"},
+		},
+		{
+			name:       "syntax error",
+			files:      map[string]string{"a.graphql": "type {"},
+			wantStatus: 2,
+			wantStderr: "a.graphql:1:6: syntax error: expected Name, found '{'\n",
+			wantFiles:  map[string]string{"a.graphql": "type {"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(dir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var stdout, stderr bytes.Buffer
+			status := run(append(tt.args, dir), strings.NewReader(""), &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("run() status = %d, want %d, stderr = %s", status, tt.wantStatus, stderr.String())
+			}
+			if got := strings.ReplaceAll(stdout.String(), dir+string(filepath.Separator), ""); got != tt.wantStdout {
+				t.Errorf("run() stdout = %q, want %q", got, tt.wantStdout)
+			}
+			if got := strings.ReplaceAll(stderr.String(), dir+string(filepath.Separator), ""); got != tt.wantStderr {
+				t.Errorf("run() stderr = %q, want %q", got, tt.wantStderr)
+			}
+			for name, want := range tt.wantFiles {
+				got, err := os.ReadFile(filepath.Join(dir, name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(got) != want {
+					t.Errorf("%s = %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRun_Stdin(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	status := run(nil, strings.NewReader(unformatted), &stdout, &stderr)
+	if status != 0 {
+		t.Errorf("run() status = %d, want 0, stderr = %s", status, stderr.String())
+	}
+	if stdout.String() != formatted {
+		t.Errorf("run() stdout = %q, want %q", stdout.String(), formatted)
+	}
+}
@@ -4,4 +4,4 @@ go 1.20
 
 require github.com/Sntree2mi8/gogqllexer v0.0.0-20230814150146-da81e4d6b155
 
-require github.com/google/go-cmp v0.5.9 // indirect
+require github.com/google/go-cmp v0.5.9
@@ -0,0 +1,346 @@
+package introspection
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/parser"
+	"github.com/Sntree2mi8/gogqlparser/printer"
+	"github.com/Sntree2mi8/gogqlparser/schema"
+	"strings"
+)
+
+// Unmarshal decodes an introspection result from JSON.
+// data is either the data of the response to the introspection query, {"__schema": ...},
+// or the whole response, {"data": {"__schema": ...}}.
+func Unmarshal(data []byte) (*Result, error) {
+	var response struct {
+		Result
+		Data *Result `json:"data"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	r := &response.Result
+	if response.Data != nil {
+		r = response.Data
+	}
+	if r.Schema == nil {
+		return nil, errors.New("introspection result has no __schema")
+	}
+	return r, nil
+}
+
+// BuildDocument returns the type system document which r describes.
+// The builtin scalars and directives of schema.BuiltinTypeSystemExtensionDocument and the introspection types are left out,
+// as is the schema definition when the root operation types follow the default names.
+// A deprecated element gets the @deprecated directive and a scalar with a specifiedByURL gets @specifiedBy.
+// Default values are parsed into DefaultValue and kept as written in RawDefaultValue.
+func BuildDocument(r *Result) (*ast.TypeSystemExtensionDocument, error) {
+	if r.Schema == nil {
+		return nil, errors.New("introspection result has no __schema")
+	}
+
+	doc := &ast.TypeSystemExtensionDocument{
+		TypeDefinitions: []ast.TypeDefinition{},
+	}
+
+	if def := schemaDefinition(r.Schema); def != nil {
+		doc.SchemaDefinitions = append(doc.SchemaDefinitions, *def)
+	}
+
+	for _, t := range r.Schema.Types {
+		if t == nil || strings.HasPrefix(t.Name, "__") || isBuiltinType(t.Name) {
+			continue
+		}
+		td, err := typeDefinition(t)
+		if err != nil {
+			return nil, fmt.Errorf("type %s: %w", t.Name, err)
+		}
+		doc.TypeDefinitions = append(doc.TypeDefinitions, td)
+	}
+
+	for _, d := range r.Schema.Directives {
+		if d == nil || isBuiltinDirective(d.Name) {
+			continue
+		}
+		dd, err := directiveDefinition(d)
+		if err != nil {
+			return nil, fmt.Errorf("directive @%s: %w", d.Name, err)
+		}
+		doc.DirectiveDefinitions = append(doc.DirectiveDefinitions, *dd)
+	}
+
+	return doc, nil
+}
+
+// schemaDefinition returns the schema definition of s, or nil if the root operation types follow the default names
+// and s has no description.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Root-Operation-Types.Default-Root-Operation-Type-Names
+func schemaDefinition(s *Schema) *ast.SchemaDefinition {
+	defined := make(map[string]bool, len(s.Types))
+	for _, t := range s.Types {
+		if t != nil {
+			defined[t.Name] = true
+		}
+	}
+
+	def := &ast.SchemaDefinition{Description: description(s.Description)}
+	conventional := def.Description == ""
+	for _, root := range []struct {
+		defaultName string
+		typ         *RootType
+		def         **ast.RootOperationTypeDefinition
+	}{
+		{defaultName: "Query", typ: s.QueryType, def: &def.Query},
+		{defaultName: "Mutation", typ: s.MutationType, def: &def.Mutation},
+		{defaultName: "Subscription", typ: s.SubscriptionType, def: &def.Subscription},
+	} {
+		if root.typ == nil {
+			// without a schema definition, a type with the default name would become the root operation type.
+			conventional = conventional && !defined[root.defaultName]
+			continue
+		}
+		*root.def = &ast.RootOperationTypeDefinition{Type: root.typ.Name}
+		conventional = conventional && root.typ.Name == root.defaultName
+	}
+
+	if conventional {
+		return nil
+	}
+	return def
+}
+
+func typeDefinition(t *Type) (ast.TypeDefinition, error) {
+	switch t.Kind {
+	case TypeKindScalar:
+		def := &ast.ScalarTypeDefinition{
+			Description: description(t.Description),
+			Name:        t.Name,
+		}
+		if t.SpecifiedByURL != nil {
+			def.Directives = append(def.Directives, ast.Directive{
+				Name:      "specifiedBy",
+				Arguments: []ast.Argument{{Name: "url", Value: ast.StringValue{Value: *t.SpecifiedByURL}}},
+			})
+		}
+		return def, nil
+	case TypeKindObject:
+		fields, err := fieldDefinitions(t.Fields)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.ObjectTypeDefinition{
+			Description:      description(t.Description),
+			Name:             t.Name,
+			Interfaces:       typeNames(t.Interfaces),
+			FieldDefinitions: fields,
+		}, nil
+	case TypeKindInterface:
+		fields, err := fieldDefinitions(t.Fields)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.InterfaceTypeDefinition{
+			Description:      description(t.Description),
+			Name:             t.Name,
+			Interfaces:       typeNames(t.Interfaces),
+			FieldDefinitions: fields,
+		}, nil
+	case TypeKindUnion:
+		def := &ast.UnionTypeDefinition{
+			Description: description(t.Description),
+			Name:        t.Name,
+		}
+		for _, name := range typeNames(t.PossibleTypes) {
+			def.MemberTypes = append(def.MemberTypes, ast.Type{NamedType: name})
+		}
+		return def, nil
+	case TypeKindEnum:
+		def := &ast.EnumTypeDefinition{
+			Description: description(t.Description),
+			Name:        t.Name,
+		}
+		for _, ev := range t.EnumValues {
+			def.EnumValue = append(def.EnumValue, ast.EnumValueDefinition{
+				Description: description(ev.Description),
+				Value:       ast.EnumValue{Value: ev.Name},
+				Directives:  deprecated(ev.IsDeprecated, ev.DeprecationReason),
+			})
+		}
+		return def, nil
+	case TypeKindInputObject:
+		fields, err := inputValueDefinitions(t.InputFields)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.InputObjectTypeDefinition{
+			Description: description(t.Description),
+			Name:        t.Name,
+			InputFields: fields,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown type kind %q", t.Kind)
+	}
+}
+
+func fieldDefinitions(fields []*Field) ([]*ast.FieldDefinition, error) {
+	defs := make([]*ast.FieldDefinition, 0, len(fields))
+	for _, f := range fields {
+		typ, err := typeOf(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		args, err := inputValueDefinitions(f.Args)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		defs = append(defs, &ast.FieldDefinition{
+			Description:        description(f.Description),
+			Name:               f.Name,
+			ArgumentDefinition: args,
+			Type:               typ,
+			Directives:         deprecated(f.IsDeprecated, f.DeprecationReason),
+		})
+	}
+	return defs, nil
+}
+
+func inputValueDefinitions(values []*InputValue) ([]ast.InputValueDefinition, error) {
+	var defs []ast.InputValueDefinition
+	for _, v := range values {
+		typ, err := typeOf(v.Type)
+		if err != nil {
+			return nil, fmt.Errorf("input value %s: %w", v.Name, err)
+		}
+		def := ast.InputValueDefinition{
+			Description: description(v.Description),
+			Name:        v.Name,
+			Type:        typ,
+			Directives:  deprecated(v.IsDeprecated, v.DeprecationReason),
+		}
+		if v.DefaultValue != nil {
+			def.RawDefaultValue = *v.DefaultValue
+			def.DefaultValue, err = parser.ParseConstValue(&ast.Source{Name: "defaultValue", Body: *v.DefaultValue})
+			if err != nil {
+				return nil, fmt.Errorf("default value of input value %s: %w", v.Name, err)
+			}
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+func directiveDefinition(d *Directive) (*ast.DirectiveDefinition, error) {
+	args, err := inputValueDefinitions(d.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	def := &ast.DirectiveDefinition{
+		Description:         description(d.Description),
+		Name:                d.Name,
+		ArgumentsDefinition: args,
+		IsRepeatable:        d.IsRepeatable,
+	}
+	for _, name := range d.Locations {
+		loc, ok := directiveLocations[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown directive location %q", name)
+		}
+		def.DirectiveLocations = append(def.DirectiveLocations, loc)
+	}
+	return def, nil
+}
+
+// typeOf returns the type which ref refers to.
+func typeOf(ref *TypeRef) (ast.Type, error) {
+	if ref == nil {
+		return ast.Type{}, errors.New("missing type reference")
+	}
+
+	switch ref.Kind {
+	case TypeKindNonNull:
+		t, err := typeOf(ref.OfType)
+		if err != nil {
+			return t, err
+		}
+		if t.NotNull {
+			return t, errors.New("non-null type must not wrap a non-null type")
+		}
+		t.NotNull = true
+		return t, nil
+	case TypeKindList:
+		t, err := typeOf(ref.OfType)
+		if err != nil {
+			return t, err
+		}
+		return ast.Type{ListType: &t}, nil
+	default:
+		if ref.Name == nil {
+			return ast.Type{}, fmt.Errorf("missing name of %s type reference", ref.Kind)
+		}
+		return ast.Type{NamedType: *ref.Name}, nil
+	}
+}
+
+func typeNames(refs []*TypeRef) []string {
+	var names []string
+	for _, ref := range refs {
+		if ref != nil && ref.Name != nil {
+			names = append(names, *ref.Name)
+		}
+	}
+	return names
+}
+
+// description returns s as a raw description, which is a string literal.
+func description(s *string) string {
+	if s == nil || *s == "" {
+		return ""
+	}
+	return printer.FormatValue(ast.StringValue{Value: *s})
+}
+
+// deprecated returns the @deprecated directive for a deprecated element, or nil.
+func deprecated(isDeprecated bool, reason *string) []ast.Directive {
+	if !isDeprecated {
+		return nil
+	}
+
+	d := ast.Directive{Name: "deprecated"}
+	if reason != nil {
+		d.Arguments = []ast.Argument{{Name: "reason", Value: ast.StringValue{Value: *reason}}}
+	}
+	return []ast.Directive{d}
+}
+
+func isBuiltinType(name string) bool {
+	for _, td := range schema.BuiltinTypeSystemExtensionDocument.TypeDefinitions {
+		if td.TypeName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isBuiltinDirective(name string) bool {
+	for _, dd := range schema.BuiltinTypeSystemExtensionDocument.DirectiveDefinitions {
+		if dd.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// directiveLocations maps the names of directive locations to them.
+var directiveLocations = func() map[string]ast.DirectiveLocation {
+	locations := make(map[string]ast.DirectiveLocation)
+	for loc := ast.DirectiveLocationSchema; loc <= ast.DirectiveLocationVariableDefinition; loc++ {
+		locations[loc.String()] = loc
+	}
+	return locations
+}()
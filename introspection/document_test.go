@@ -0,0 +1,222 @@
+package introspection
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/printer"
+	"github.com/Sntree2mi8/gogqlparser/validator"
+	"testing"
+)
+
+func TestBuildDocument_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+	}{
+		{
+			name: "every kind of definition",
+			schema: `"Caches the field"
+directive @cache(ttl: Int!) repeatable on FIELD_DEFINITION | OBJECT
+
+"The root"
+type Query implements Node {
+  id: ID!
+  "Lists users"
+  users(first: Int = 10, roles: [Role!]! = [ADMIN], filter: Filter = {name: "a", tags: ["b"]}): [User!] @deprecated(reason: "No longer supported")
+  search(text: String @deprecated(reason: "unused")): SearchResult @deprecated(reason: "Use users")
+}
+
+interface Node {
+  id: ID!
+}
+
+type User implements Node {
+  id: ID!
+}
+
+union SearchResult = User
+
+enum Role {
+  ADMIN @deprecated(reason: "Use USER")
+  USER
+}
+
+input Filter {
+  name: String = "a"
+  tags: [String]
+}
+
+scalar Time @specifiedBy(url: "https://example.com/time")
+`,
+		},
+		{
+			name: "root operation types without default names",
+			schema: `schema {
+  query: Root
+  subscription: Events
+}
+
+type Root {
+  a: Int
+}
+
+type Events {
+  a: Int
+}
+
+type Mutation {
+  a: Int
+}
+`,
+		},
+		{
+			name: "schema description",
+			schema: `"The schema"
+schema {
+  query: Query
+}
+
+type Query {
+  a: Int
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Introspect(mustSchema(t, tt.schema))
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.Marshal(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r, err = Unmarshal(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := BuildDocument(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = validator.ValidateTypeSystemExtensionDocument(doc); err != nil {
+				t.Errorf("ValidateTypeSystemExtensionDocument() error = %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err = printer.Fprint(&buf, doc); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.schema {
+				t.Errorf("BuildDocument() got =\n%s\nwant =\n%s", buf.String(), tt.schema)
+			}
+		})
+	}
+}
+
+func TestBuildDocument_DefaultValue(t *testing.T) {
+	r, err := Unmarshal([]byte(`{"__schema": {"queryType": {"name": "Query"}, "types": [
+  {"kind": "OBJECT", "name": "Query", "interfaces": [], "fields": [
+    {"name": "a", "args": [{"name": "b", "type": {"kind": "SCALAR", "name": "Int"}, "defaultValue": "1"}], "type": {"kind": "SCALAR", "name": "Int"}}
+  ]}
+], "directives": []}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := BuildDocument(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	arg := doc.TypeDefinitions[0].(*ast.ObjectTypeDefinition).FieldDefinitions[0].ArgumentDefinition[0]
+	if arg.RawDefaultValue != "1" {
+		t.Errorf("RawDefaultValue = %q, want 1", arg.RawDefaultValue)
+	}
+	if v, ok := arg.DefaultValue.(ast.IntValue); !ok || v.Value != 1 {
+		t.Errorf("DefaultValue = %#v, want 1", arg.DefaultValue)
+	}
+}
+
+func TestBuildDocument_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{
+			name: "unknown type kind",
+			json: `{"__schema": {"types": [{"kind": "TABLE", "name": "A"}]}}`,
+		},
+		{
+			name: "missing type reference",
+			json: `{"__schema": {"types": [{"kind": "OBJECT", "name": "A", "fields": [{"name": "a"}]}]}}`,
+		},
+		{
+			name: "non-null of non-null",
+			json: `{"__schema": {"types": [{"kind": "INPUT_OBJECT", "name": "A", "inputFields": [
+  {"name": "a", "type": {"kind": "NON_NULL", "ofType": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "Int"}}}}
+]}]}}`,
+		},
+		{
+			name: "invalid default value",
+			json: `{"__schema": {"types": [{"kind": "INPUT_OBJECT", "name": "A", "inputFields": [
+  {"name": "a", "type": {"kind": "SCALAR", "name": "Int"}, "defaultValue": "$a"}
+]}]}}`,
+		},
+		{
+			name: "unknown directive location",
+			json: `{"__schema": {"types": [], "directives": [{"name": "a", "locations": ["TABLE"], "args": []}]}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Unmarshal([]byte(tt.json))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = BuildDocument(r); err == nil {
+				t.Errorf("BuildDocument() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr bool
+	}{
+		{
+			name: "data",
+			json: `{"__schema": {"queryType": {"name": "Query"}}}`,
+		},
+		{
+			name: "response",
+			json: `{"data": {"__schema": {"queryType": {"name": "Query"}}}}`,
+		},
+		{
+			name:    "without __schema",
+			json:    `{"data": null, "errors": [{"message": "introspection is disabled"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			json:    `{`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Unmarshal([]byte(tt.json))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && r.Schema.QueryType.Name != "Query" {
+				t.Errorf("Unmarshal() queryType = %v, want Query", r.Schema.QueryType)
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+// Package introspection produces the result of the introspection query from a schema,
+// for tools such as GraphiQL and client code generators which read a schema from it.
+package introspection
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/parser"
+	"github.com/Sntree2mi8/gogqlparser/printer"
+	"github.com/Sntree2mi8/gogqlparser/schema"
+)
+
+// Result is the data of the response to the canonical IntrospectionQuery, requested with descriptions,
+// specifiedByURL, isRepeatable, the schema description and the deprecation of input values.
+// It encodes to the JSON the query returns with encoding/json.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Schema-Introspection
+type Result struct {
+	Schema *Schema `json:"__schema"`
+}
+
+type Schema struct {
+	Description      *string      `json:"description"`
+	QueryType        *RootType    `json:"queryType"`
+	MutationType     *RootType    `json:"mutationType"`
+	SubscriptionType *RootType    `json:"subscriptionType"`
+	Types            []*Type      `json:"types"`
+	Directives       []*Directive `json:"directives"`
+}
+
+// RootType names a root operation type.
+type RootType struct {
+	Name string `json:"name"`
+}
+
+type TypeKind string
+
+const (
+	TypeKindScalar      TypeKind = "SCALAR"
+	TypeKindObject      TypeKind = "OBJECT"
+	TypeKindInterface   TypeKind = "INTERFACE"
+	TypeKindUnion       TypeKind = "UNION"
+	TypeKindEnum        TypeKind = "ENUM"
+	TypeKindInputObject TypeKind = "INPUT_OBJECT"
+	TypeKindList        TypeKind = "LIST"
+	TypeKindNonNull     TypeKind = "NON_NULL"
+)
+
+// Type is a named type. Fields, InputFields, Interfaces, EnumValues and PossibleTypes are nil
+// unless the type is of a kind which has them.
+type Type struct {
+	Kind           TypeKind      `json:"kind"`
+	Name           string        `json:"name"`
+	Description    *string       `json:"description"`
+	SpecifiedByURL *string       `json:"specifiedByURL"`
+	Fields         []*Field      `json:"fields"`
+	InputFields    []*InputValue `json:"inputFields"`
+	Interfaces     []*TypeRef    `json:"interfaces"`
+	EnumValues     []*EnumValue  `json:"enumValues"`
+	PossibleTypes  []*TypeRef    `json:"possibleTypes"`
+}
+
+type Field struct {
+	Name              string        `json:"name"`
+	Description       *string       `json:"description"`
+	Args              []*InputValue `json:"args"`
+	Type              *TypeRef      `json:"type"`
+	IsDeprecated      bool          `json:"isDeprecated"`
+	DeprecationReason *string       `json:"deprecationReason"`
+}
+
+// InputValue is an argument or an input field. DefaultValue is written in GraphQL syntax, e.g. "[1, 2]".
+type InputValue struct {
+	Name              string   `json:"name"`
+	Description       *string  `json:"description"`
+	Type              *TypeRef `json:"type"`
+	DefaultValue      *string  `json:"defaultValue"`
+	IsDeprecated      bool     `json:"isDeprecated"`
+	DeprecationReason *string  `json:"deprecationReason"`
+}
+
+type EnumValue struct {
+	Name              string  `json:"name"`
+	Description       *string `json:"description"`
+	IsDeprecated      bool    `json:"isDeprecated"`
+	DeprecationReason *string `json:"deprecationReason"`
+}
+
+type Directive struct {
+	Name         string        `json:"name"`
+	Description  *string       `json:"description"`
+	IsRepeatable bool          `json:"isRepeatable"`
+	Locations    []string      `json:"locations"`
+	Args         []*InputValue `json:"args"`
+}
+
+// TypeRef refers to a named type, or wraps OfType in a list or a non-null type.
+type TypeRef struct {
+	Kind   TypeKind `json:"kind"`
+	Name   *string  `json:"name"`
+	OfType *TypeRef `json:"ofType"`
+}
+
+// Introspect returns the introspection result of s, which should be valid.
+// The types of s are listed in the order of its document, followed by the types of schema.IntrospectionTypeSystemExtensionDocument.
+// It fails only if a description is not a valid string literal.
+func Introspect(s *schema.Schema) (*Result, error) {
+	in := &introspector{schema: s}
+
+	doc := s.Document()
+	result := &Schema{
+		QueryType:        rootType(s.QueryType()),
+		MutationType:     rootType(s.MutationType()),
+		SubscriptionType: rootType(s.SubscriptionType()),
+		Types:            make([]*Type, 0, len(doc.TypeDefinitions)+len(schema.IntrospectionTypeSystemExtensionDocument.TypeDefinitions)),
+		Directives:       make([]*Directive, 0, len(doc.DirectiveDefinitions)),
+	}
+	if len(doc.SchemaDefinitions) > 0 {
+		result.Description = in.description(doc.SchemaDefinitions[0].Description)
+	}
+
+	for _, td := range doc.TypeDefinitions {
+		result.Types = append(result.Types, in.typ(td))
+	}
+	for _, td := range schema.IntrospectionTypeSystemExtensionDocument.TypeDefinitions {
+		result.Types = append(result.Types, in.typ(td))
+	}
+	for i := range doc.DirectiveDefinitions {
+		result.Directives = append(result.Directives, in.directive(&doc.DirectiveDefinitions[i]))
+	}
+
+	if in.err != nil {
+		return nil, in.err
+	}
+	return &Result{Schema: result}, nil
+}
+
+type introspector struct {
+	schema *schema.Schema
+	// err is the first error, the introspection goes on to the end without it.
+	err error
+}
+
+func rootType(def *ast.ObjectTypeDefinition) *RootType {
+	if def == nil {
+		return nil
+	}
+	return &RootType{Name: def.Name}
+}
+
+func (in *introspector) typ(td ast.TypeDefinition) *Type {
+	t := &Type{Name: td.TypeName()}
+
+	switch td := td.(type) {
+	case *ast.ScalarTypeDefinition:
+		t.Kind = TypeKindScalar
+		t.Description = in.description(td.Description)
+		t.SpecifiedByURL = specifiedByURL(td.Directives)
+	case *ast.ObjectTypeDefinition:
+		t.Kind = TypeKindObject
+		t.Description = in.description(td.Description)
+		t.Fields = in.fields(td.FieldDefinitions)
+		t.Interfaces = namedTypeRefs(td.Interfaces, TypeKindInterface)
+	case *ast.InterfaceTypeDefinition:
+		t.Kind = TypeKindInterface
+		t.Description = in.description(td.Description)
+		t.Fields = in.fields(td.FieldDefinitions)
+		t.Interfaces = namedTypeRefs(td.Interfaces, TypeKindInterface)
+		t.PossibleTypes = in.possibleTypes(td)
+	case *ast.UnionTypeDefinition:
+		t.Kind = TypeKindUnion
+		t.Description = in.description(td.Description)
+		t.PossibleTypes = in.possibleTypes(td)
+	case *ast.EnumTypeDefinition:
+		t.Kind = TypeKindEnum
+		t.Description = in.description(td.Description)
+		t.EnumValues = make([]*EnumValue, len(td.EnumValue))
+		for i, ev := range td.EnumValue {
+			t.EnumValues[i] = &EnumValue{
+				Name:        ev.Value.Value,
+				Description: in.description(ev.Description),
+			}
+			t.EnumValues[i].IsDeprecated, t.EnumValues[i].DeprecationReason = in.deprecation(ev.Directives)
+		}
+	case *ast.InputObjectTypeDefinition:
+		t.Kind = TypeKindInputObject
+		t.Description = in.description(td.Description)
+		t.InputFields = in.inputValues(td.InputFields)
+	}
+
+	return t
+}
+
+func (in *introspector) fields(defs []*ast.FieldDefinition) []*Field {
+	fields := make([]*Field, len(defs))
+	for i, def := range defs {
+		fields[i] = &Field{
+			Name:        def.Name,
+			Description: in.description(def.Description),
+			Args:        in.inputValues(def.ArgumentDefinition),
+			Type:        in.typeRef(def.Type),
+		}
+		fields[i].IsDeprecated, fields[i].DeprecationReason = in.deprecation(def.Directives)
+	}
+	return fields
+}
+
+func (in *introspector) inputValues(defs []ast.InputValueDefinition) []*InputValue {
+	values := make([]*InputValue, len(defs))
+	for i, def := range defs {
+		values[i] = &InputValue{
+			Name:        def.Name,
+			Description: in.description(def.Description),
+			Type:        in.typeRef(def.Type),
+		}
+		switch {
+		case def.DefaultValue != nil:
+			v := printer.FormatValue(def.DefaultValue)
+			values[i].DefaultValue = &v
+		case def.RawDefaultValue != "":
+			v := def.RawDefaultValue
+			values[i].DefaultValue = &v
+		}
+		values[i].IsDeprecated, values[i].DeprecationReason = in.deprecation(def.Directives)
+	}
+	return values
+}
+
+func (in *introspector) directive(def *ast.DirectiveDefinition) *Directive {
+	d := &Directive{
+		Name:         def.Name,
+		Description:  in.description(def.Description),
+		IsRepeatable: def.IsRepeatable,
+		Locations:    make([]string, len(def.DirectiveLocations)),
+		Args:         in.inputValues(def.ArgumentsDefinition),
+	}
+	for i, loc := range def.DirectiveLocations {
+		d.Locations[i] = loc.String()
+	}
+	return d
+}
+
+func (in *introspector) possibleTypes(abstract ast.TypeDefinition) []*TypeRef {
+	objects := in.schema.PossibleTypes(abstract)
+	refs := make([]*TypeRef, len(objects))
+	for i, obj := range objects {
+		refs[i] = namedTypeRef(obj.Name, TypeKindObject)
+	}
+	return refs
+}
+
+// typeRef returns the reference to t. A named type which is not defined is referred as a scalar.
+func (in *introspector) typeRef(t ast.Type) *TypeRef {
+	var ref *TypeRef
+	if t.ListType != nil {
+		ref = &TypeRef{Kind: TypeKindList, OfType: in.typeRef(*t.ListType)}
+	} else {
+		ref = namedTypeRef(t.NamedType, in.kind(t.NamedType))
+	}
+
+	if t.NotNull {
+		return &TypeRef{Kind: TypeKindNonNull, OfType: ref}
+	}
+	return ref
+}
+
+func (in *introspector) kind(name string) TypeKind {
+	td := in.schema.Type(name)
+	if td == nil {
+		return TypeKindScalar
+	}
+
+	switch td.TypeDefinitionKind() {
+	case ast.TypeDefinitionKindObject:
+		return TypeKindObject
+	case ast.TypeDefinitionKindInterface:
+		return TypeKindInterface
+	case ast.TypeDefinitionKindUnion:
+		return TypeKindUnion
+	case ast.TypeDefinitionKindEnum:
+		return TypeKindEnum
+	case ast.TypeDefinitionKindInputObject:
+		return TypeKindInputObject
+	default:
+		return TypeKindScalar
+	}
+}
+
+func namedTypeRef(name string, kind TypeKind) *TypeRef {
+	return &TypeRef{Kind: kind, Name: &name}
+}
+
+func namedTypeRefs(names []string, kind TypeKind) []*TypeRef {
+	refs := make([]*TypeRef, len(names))
+	for i, name := range names {
+		refs[i] = namedTypeRef(name, kind)
+	}
+	return refs
+}
+
+// description decodes the raw description of a definition, which is nil if the definition has none.
+func (in *introspector) description(raw string) *string {
+	if raw == "" {
+		return nil
+	}
+	s, err := parser.StringValue(raw)
+	if err != nil {
+		if in.err == nil {
+			in.err = fmt.Errorf("invalid description %s: %w", raw, err)
+		}
+		return nil
+	}
+	return &s
+}
+
+// deprecation returns whether directives deprecate the element they are applied to, and the reason.
+// Without the reason argument, the reason is the default value of the argument.
+//
+// Reference: https://spec.graphql.org/October2021/#sec--deprecated
+func (in *introspector) deprecation(directives []ast.Directive) (bool, *string) {
+	for _, d := range directives {
+		if d.Name != "deprecated" {
+			continue
+		}
+
+		for _, arg := range d.Arguments {
+			if arg.Name == "reason" {
+				return true, stringValue(arg.Value)
+			}
+		}
+		if dd := in.schema.Directive("deprecated"); dd != nil {
+			for _, ad := range dd.ArgumentsDefinition {
+				if ad.Name == "reason" {
+					return true, stringValue(ad.DefaultValue)
+				}
+			}
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// https://spec.graphql.org/October2021/#sec--specifiedBy
+func specifiedByURL(directives []ast.Directive) *string {
+	for _, d := range directives {
+		if d.Name != "specifiedBy" {
+			continue
+		}
+		for _, arg := range d.Arguments {
+			if arg.Name == "url" {
+				return stringValue(arg.Value)
+			}
+		}
+	}
+	return nil
+}
+
+// stringValue returns the string of v, or nil if v is not a string.
+func stringValue(v ast.Value) *string {
+	s, ok := v.(ast.StringValue)
+	if !ok {
+		return nil
+	}
+	return &s.Value
+}
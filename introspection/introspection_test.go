@@ -0,0 +1,269 @@
+package introspection
+
+import (
+	"encoding/json"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/parser"
+	"github.com/Sntree2mi8/gogqlparser/schema"
+	"github.com/google/go-cmp/cmp"
+	"strings"
+	"testing"
+)
+
+func mustSchema(t *testing.T, body string) *schema.Schema {
+	t.Helper()
+	doc, err := parser.ParseTypeSystemExtensionDocument(&ast.Source{Name: "schema.graphql", Body: body})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := schema.New(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func named(kind TypeKind, name string) *TypeRef {
+	return &TypeRef{Kind: kind, Name: &name}
+}
+
+func TestIntrospect(t *testing.T) {
+	s := mustSchema(t, `
+"""
+The schema
+"""
+schema { query: Query mutation: Mutation }
+
+"The root"
+type Query implements Node {
+  id: ID!
+  users(first: Int = 10, roles: [Role!]! = [ADMIN], filter: Filter): [User!] @deprecated
+  search: SearchResult @deprecated(reason: "Use users")
+}
+type Mutation { a(b: String @deprecated(reason: "unused")): Int }
+interface Node { id: ID! }
+type User implements Node { id: ID! }
+union SearchResult = User
+enum Role { ADMIN @deprecated(reason: "Use USER") USER }
+input Filter { name: String = "a" }
+scalar Time @specifiedBy(url: "https://example.com/time")
+"Caches the field"
+directive @cache(ttl: Int!) repeatable on FIELD_DEFINITION | OBJECT
+`)
+
+	got, err := Introspect(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("schema", func(t *testing.T) {
+		if diff := cmp.Diff(ptr("The schema"), got.Schema.Description); diff != "" {
+			t.Errorf("description mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(&RootType{Name: "Query"}, got.Schema.QueryType); diff != "" {
+			t.Errorf("queryType mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(&RootType{Name: "Mutation"}, got.Schema.MutationType); diff != "" {
+			t.Errorf("mutationType mismatch (-want +got):\n%s", diff)
+		}
+		if got.Schema.SubscriptionType != nil {
+			t.Errorf("subscriptionType = %v, want nil", got.Schema.SubscriptionType)
+		}
+
+		var names []string
+		for _, typ := range got.Schema.Types {
+			names = append(names, typ.Name)
+		}
+		want := []string{
+			"Query", "Mutation", "Node", "User", "SearchResult", "Role", "Filter", "Time",
+			"Int", "Float", "String", "Boolean", "ID",
+			"__Schema", "__Type", "__TypeKind", "__Field", "__InputValue", "__EnumValue", "__Directive", "__DirectiveLocation",
+		}
+		if diff := cmp.Diff(want, names); diff != "" {
+			t.Errorf("types mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	types := make(map[string]*Type)
+	for _, typ := range got.Schema.Types {
+		types[typ.Name] = typ
+	}
+
+	tests := []struct {
+		name string
+		want *Type
+	}{
+		{
+			name: "Query",
+			want: &Type{
+				Kind:        TypeKindObject,
+				Name:        "Query",
+				Description: ptr("The root"),
+				Fields: []*Field{
+					{
+						Name: "id",
+						Args: []*InputValue{},
+						Type: &TypeRef{Kind: TypeKindNonNull, OfType: named(TypeKindScalar, "ID")},
+					},
+					{
+						Name: "users",
+						Args: []*InputValue{
+							{Name: "first", Type: named(TypeKindScalar, "Int"), DefaultValue: ptr("10")},
+							{
+								Name: "roles",
+								Type: &TypeRef{Kind: TypeKindNonNull, OfType: &TypeRef{
+									Kind:   TypeKindList,
+									OfType: &TypeRef{Kind: TypeKindNonNull, OfType: named(TypeKindEnum, "Role")},
+								}},
+								DefaultValue: ptr("[ADMIN]"),
+							},
+							{Name: "filter", Type: named(TypeKindInputObject, "Filter")},
+						},
+						Type:              &TypeRef{Kind: TypeKindList, OfType: &TypeRef{Kind: TypeKindNonNull, OfType: named(TypeKindObject, "User")}},
+						IsDeprecated:      true,
+						DeprecationReason: ptr("No longer supported"),
+					},
+					{
+						Name:              "search",
+						Args:              []*InputValue{},
+						Type:              named(TypeKindUnion, "SearchResult"),
+						IsDeprecated:      true,
+						DeprecationReason: ptr("Use users"),
+					},
+				},
+				Interfaces: []*TypeRef{named(TypeKindInterface, "Node")},
+			},
+		},
+		{
+			name: "Mutation",
+			want: &Type{
+				Kind: TypeKindObject,
+				Name: "Mutation",
+				Fields: []*Field{
+					{
+						Name: "a",
+						Args: []*InputValue{
+							{Name: "b", Type: named(TypeKindScalar, "String"), IsDeprecated: true, DeprecationReason: ptr("unused")},
+						},
+						Type: named(TypeKindScalar, "Int"),
+					},
+				},
+				Interfaces: []*TypeRef{},
+			},
+		},
+		{
+			name: "Node",
+			want: &Type{
+				Kind: TypeKindInterface,
+				Name: "Node",
+				Fields: []*Field{
+					{Name: "id", Args: []*InputValue{}, Type: &TypeRef{Kind: TypeKindNonNull, OfType: named(TypeKindScalar, "ID")}},
+				},
+				Interfaces:    []*TypeRef{},
+				PossibleTypes: []*TypeRef{named(TypeKindObject, "Query"), named(TypeKindObject, "User")},
+			},
+		},
+		{
+			name: "SearchResult",
+			want: &Type{
+				Kind:          TypeKindUnion,
+				Name:          "SearchResult",
+				PossibleTypes: []*TypeRef{named(TypeKindObject, "User")},
+			},
+		},
+		{
+			name: "Role",
+			want: &Type{
+				Kind: TypeKindEnum,
+				Name: "Role",
+				EnumValues: []*EnumValue{
+					{Name: "ADMIN", IsDeprecated: true, DeprecationReason: ptr("Use USER")},
+					{Name: "USER"},
+				},
+			},
+		},
+		{
+			name: "Filter",
+			want: &Type{
+				Kind: TypeKindInputObject,
+				Name: "Filter",
+				InputFields: []*InputValue{
+					{Name: "name", Type: named(TypeKindScalar, "String"), DefaultValue: ptr(`"a"`)},
+				},
+			},
+		},
+		{
+			name: "Time",
+			want: &Type{
+				Kind:           TypeKindScalar,
+				Name:           "Time",
+				SpecifiedByURL: ptr("https://example.com/time"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, types[tt.name]); diff != "" {
+				t.Errorf("type mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("directives", func(t *testing.T) {
+		want := &Directive{
+			Name:         "cache",
+			Description:  ptr("Caches the field"),
+			IsRepeatable: true,
+			Locations:    []string{"FIELD_DEFINITION", "OBJECT"},
+			Args: []*InputValue{
+				{Name: "ttl", Type: &TypeRef{Kind: TypeKindNonNull, OfType: named(TypeKindScalar, "Int")}},
+			},
+		}
+		var names []string
+		for _, d := range got.Schema.Directives {
+			names = append(names, d.Name)
+			if d.Name == want.Name {
+				if diff := cmp.Diff(want, d); diff != "" {
+					t.Errorf("directive mismatch (-want +got):\n%s", diff)
+				}
+			}
+		}
+		if diff := cmp.Diff([]string{"cache", "include", "skip", "deprecated", "specifiedBy"}, names); diff != "" {
+			t.Errorf("directives mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestIntrospect_JSON(t *testing.T) {
+	got, err := Introspect(mustSchema(t, `type Query { a: Int }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`{"__schema":{"description":null,"queryType":{"name":"Query"},"mutationType":null,"subscriptionType":null,"types":[`,
+		`{"kind":"OBJECT","name":"Query","description":null,"specifiedByURL":null,"fields":[{"name":"a","description":null,"args":[],"type":{"kind":"SCALAR","name":"Int","ofType":null},"isDeprecated":false,"deprecationReason":null}],"inputFields":null,"interfaces":[],"enumValues":null,"possibleTypes":null}`,
+		`{"name":"skip","description":null,"isRepeatable":false,"locations":["FIELD","FRAGMENT_SPREAD","INLINE_FRAGMENT"],"args":[{"name":"if","description":null,"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"Boolean","ofType":null}},"defaultValue":null,"isDeprecated":false,"deprecationReason":null}]}`,
+	} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("json.Marshal() = %s, want to contain %s", b, want)
+		}
+	}
+}
+
+func TestIntrospect_InvalidDescription(t *testing.T) {
+	s := mustSchema(t, `type Query { a: Int }`)
+	s.Type("Query").(*ast.ObjectTypeDefinition).Description = "not a string"
+
+	if _, err := Introspect(s); err == nil {
+		t.Errorf("Introspect() error = nil, want error")
+	}
+}
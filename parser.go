@@ -1,8 +1,13 @@
 package gogqlparser
 
 import (
+	"errors"
+	"fmt"
 	"github.com/Sntree2mi8/gogqlparser/ast"
 	"github.com/Sntree2mi8/gogqlparser/parser"
+	"runtime"
+	"strings"
+	"sync"
 )
 
 type Parser struct {
@@ -12,21 +17,90 @@ func New() *Parser {
 	return &Parser{}
 }
 
+// SourceError is an error raised while parsing Source.
+type SourceError struct {
+	Source *ast.Source
+	Err    error
+}
+
+func (e *SourceError) Error() string {
+	// syntax errors already start with the source name.
+	var syntaxErr *parser.SyntaxError
+	if errors.As(e.Err, &syntaxErr) {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %v", e.Source.Name, e.Err)
+}
+
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+// SourceErrors holds every SourceError of a ParseTypeSystem call, in the order of the sources.
+type SourceErrors []*SourceError
+
+func (e SourceErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e SourceErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// ParseTypeSystem parses sources concurrently and merges them into one document.
+// Definitions of the merged document keep the order of the sources.
+// If any source fails to parse, the returned error is SourceErrors.
 func (p *Parser) ParseTypeSystem(sources []*ast.Source) (*ast.TypeSystemExtensionDocument, error) {
 	typeSystemDocs := make([]*ast.TypeSystemExtensionDocument, len(sources))
-	// TODO: parallelize
+	errs := make([]error, len(sources))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
 	for i, src := range sources {
-		doc, err := p.parseTypeSystemDocument(src)
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, src *ast.Source) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			typeSystemDocs[i], errs[i] = p.parseTypeSystemDocument(src)
+		}(i, src)
+	}
+	wg.Wait()
+
+	var sourceErrs SourceErrors
+	for i, err := range errs {
 		if err != nil {
-			return nil, err
+			sourceErrs = append(sourceErrs, &SourceError{Source: sources[i], Err: err})
 		}
-		typeSystemDocs[i] = doc
 	}
+	if len(sourceErrs) > 0 {
+		return nil, sourceErrs
+	}
+
 	return MergeTypeSystemDocument(typeSystemDocs), nil
 }
 
+// MergeTypeSystemDocument concatenates the definitions of documents in order.
+// The returned document does not share its slices with documents.
 func MergeTypeSystemDocument(documents []*ast.TypeSystemExtensionDocument) *ast.TypeSystemExtensionDocument {
-	return nil
+	others := make([]*ast.TypeSystemExtensionDocument, 0, len(documents))
+	for _, doc := range documents {
+		if doc != nil {
+			others = append(others, doc)
+		}
+	}
+
+	return (&ast.TypeSystemExtensionDocument{}).Merge(others...)
 }
 
 func (p *Parser) parseTypeSystemDocument(src *ast.Source) (*ast.TypeSystemExtensionDocument, error) {
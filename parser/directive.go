@@ -1,36 +1,33 @@
 package parser
 
 import (
-	"fmt"
 	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
 )
 
-func (p *parser) parseDirectiveArguments() (args []ast.Argument, err error) {
+// https://spec.graphql.org/October2021/#Arguments
+// variables are only allowed in executable documents, so isConst must be true in type system documents.
+func (p *parser) parseArguments(isConst bool) (args []ast.Argument, err error) {
 	if err = p.Skip(gogqllexer.ParenL); err != nil {
 		return nil, err
 	}
 
 	for {
 		arg := ast.Argument{}
+		start := p.PeekToken()
 
 		if arg.Name, err = p.ReadNameValue(); err != nil {
 			return nil, err
 		}
-		if p.Skip(gogqllexer.Colon) != nil {
-			return nil, err
+		if err = p.Skip(gogqllexer.Colon); err != nil {
+			return nil, withContext(err, "after argument name")
 		}
-		if err = p.PeekAndMustBe(
-			[]gogqllexer.Kind{gogqllexer.Int, gogqllexer.Float, gogqllexer.Name, gogqllexer.String, gogqllexer.BlockString},
-			func(t gogqllexer.Token, advanceLexer func()) error {
-				defer advanceLexer()
-				arg.Value = t.Value
-				return nil
-			},
-		); err != nil {
+
+		if arg.Value, err = p.parseValue(isConst); err != nil {
 			return nil, err
 		}
 
+		arg.Position = p.positionFrom(start)
 		args = append(args, arg)
 
 		if p.SkipIf(gogqllexer.ParenR) {
@@ -41,11 +38,11 @@ func (p *parser) parseDirectiveArguments() (args []ast.Argument, err error) {
 	return args, err
 }
 
-func (p *parser) parseDirectives() (directives []ast.Directive, err error) {
+func (p *parser) parseDirectives(isConst bool) (directives []ast.Directive, err error) {
 	for {
 		var d ast.Directive
 
-		d, err = p.parseDirective()
+		d, err = p.parseDirective(isConst)
 		if err != nil {
 			return nil, err
 		}
@@ -60,7 +57,8 @@ func (p *parser) parseDirectives() (directives []ast.Directive, err error) {
 	return directives, nil
 }
 
-func (p *parser) parseDirective() (d ast.Directive, err error) {
+func (p *parser) parseDirective(isConst bool) (d ast.Directive, err error) {
+	start := p.PeekToken()
 	if err = p.Skip(gogqllexer.At); err != nil {
 		return d, err
 	}
@@ -70,11 +68,12 @@ func (p *parser) parseDirective() (d ast.Directive, err error) {
 	}
 
 	if p.CheckKind(gogqllexer.ParenL) {
-		if d.Arguments, err = p.parseDirectiveArguments(); err != nil {
+		if d.Arguments, err = p.parseArguments(isConst); err != nil {
 			return d, err
 		}
 	}
 
+	d.Position = p.positionFrom(start)
 	return d, err
 }
 
@@ -128,13 +127,10 @@ func (p *parser) parseDirectiveLocations() (locs []ast.DirectiveLocation, err er
 	p.SkipIf(gogqllexer.Pipe)
 
 	for {
-		var locationValue string
-		if locationValue, err = p.ReadNameValue(); err != nil {
-			return nil, err
-		}
-		loc := parseDirectiveLocation(locationValue)
-		if loc == ast.DirectiveLocationUnknown {
-			return nil, fmt.Errorf("unexpected token %+v", locationValue)
+		t := p.NextToken()
+		loc := parseDirectiveLocation(t.Value)
+		if t.Kind != gogqllexer.Name || loc == ast.DirectiveLocationUnknown {
+			return nil, p.errorAt(t, "expected directive location")
 		}
 
 		locs = append(locs, loc)
@@ -152,6 +148,7 @@ func (p *parser) parseDirectiveLocations() (locs []ast.DirectiveLocation, err er
 //
 // Reference: https://spec.graphqp.org/October2021/#sec-Type-System.Directives
 func (p *parser) ParseDirectiveDefinition(description string) (def *ast.DirectiveDefinition, err error) {
+	start := p.PeekToken()
 	def = &ast.DirectiveDefinition{
 		Description: description,
 	}
@@ -177,7 +174,7 @@ func (p *parser) ParseDirectiveDefinition(description string) (def *ast.Directiv
 	}
 
 	if err = p.SkipKeyword("on"); err != nil {
-		return nil, err
+		return nil, withContext(err, "before directive locations")
 	}
 
 	def.DirectiveLocations, err = p.parseDirectiveLocations()
@@ -185,5 +182,6 @@ func (p *parser) ParseDirectiveDefinition(description string) (def *ast.Directiv
 		return nil, err
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, nil
 }
@@ -1,10 +1,8 @@
 package parser
 
 import (
-	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
-	"reflect"
-	"strings"
+	"github.com/google/go-cmp/cmp"
 	"testing"
 )
 
@@ -180,17 +178,78 @@ directive @testing on SCHEMA
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &parser{
-				lexer: gogqllexer.New(strings.NewReader(tt.schema)),
-			}
+			p := newParser(&ast.Source{Body: tt.schema})
 			gotDef, err := p.ParseDirectiveDefinition(tt.args.description)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseDirectiveDefinition() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(gotDef, tt.wantDef) {
+			if !cmp.Equal(gotDef, tt.wantDef, ignorePosition) {
 				t.Errorf("ParseDirectiveDefinition() gotDef = %v, want %v", gotDef, tt.wantDef)
 			}
 		})
 	}
 }
+
+func TestParseDirectives(t *testing.T) {
+	tests := []struct {
+		name           string
+		schema         string
+		wantDirectives []ast.Directive
+		wantErr        bool
+	}{
+		{
+			name:   "list and object arguments",
+			schema: `@key(fields: ["id"]) @auth(rules: {role: ADMIN})`,
+			wantDirectives: []ast.Directive{
+				{
+					Name: "key",
+					Arguments: []ast.Argument{
+						{
+							Name: "fields",
+							Value: ast.ListValue{
+								Values: []ast.Value{
+									ast.StringValue{Value: "id"},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "auth",
+					Arguments: []ast.Argument{
+						{
+							Name: "rules",
+							Value: ast.ObjectValue{
+								Fields: []ast.ObjectField{
+									{
+										Name:  "role",
+										Value: ast.EnumValue{Value: "ADMIN"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "variable argument in type system document",
+			schema:  `@key(fields: $fields)`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newParser(&ast.Source{Body: tt.schema})
+			gotDirectives, err := p.parseDirectives(true)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseDirectives() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !cmp.Equal(gotDirectives, tt.wantDirectives, ignorePosition) {
+				t.Errorf("parseDirectives() gotDirectives = %v, want %v", gotDirectives, tt.wantDirectives)
+			}
+		})
+	}
+}
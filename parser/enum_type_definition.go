@@ -14,17 +14,19 @@ func (p *parser) parseEnumValuesDefinition() (enumValuesDef []ast.EnumValueDefin
 	for {
 		var enumValueDef ast.EnumValueDefinition
 		enumValueDef.Description, _ = p.ReadDescription()
+		start := p.PeekToken()
 
 		if enumValueDef.Value.Value, err = p.ReadNameValue(); err != nil {
 			return nil, err
 		}
 
 		if p.CheckKind(gogqllexer.At) {
-			if enumValueDef.Directives, err = p.parseDirectives(); err != nil {
+			if enumValueDef.Directives, err = p.parseDirectives(true); err != nil {
 				return nil, err
 			}
 		}
 
+		enumValueDef.Position = p.positionFrom(start)
 		enumValuesDef = append(enumValuesDef, enumValueDef)
 
 		if p.SkipIf(gogqllexer.BraceR) {
@@ -36,6 +38,7 @@ func (p *parser) parseEnumValuesDefinition() (enumValuesDef []ast.EnumValueDefin
 
 // https://spec.graphql.org/October2021/#sec-Enums
 func (p *parser) ParseEnumTypeDefinition(description string) (def *ast.EnumTypeDefinition, err error) {
+	start := p.PeekToken()
 	def = &ast.EnumTypeDefinition{
 		Description: description,
 	}
@@ -49,7 +52,7 @@ func (p *parser) ParseEnumTypeDefinition(description string) (def *ast.EnumTypeD
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	}
@@ -58,12 +61,14 @@ func (p *parser) ParseEnumTypeDefinition(description string) (def *ast.EnumTypeD
 		return nil, err
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, nil
 }
 
 // https://spec.graphql.org/October2021/#sec-Enum-Extensions
 // NOTION: consume "extend" keyword before call this function.
 func (p *parser) ParseEnumTypeExtension() (def *ast.EnumTypeExtension, err error) {
+	start := p.PeekToken()
 	def = &ast.EnumTypeExtension{}
 
 	if err := p.SkipKeyword("enum"); err != nil {
@@ -75,7 +80,7 @@ func (p *parser) ParseEnumTypeExtension() (def *ast.EnumTypeExtension, err error
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	}
@@ -86,5 +91,6 @@ func (p *parser) ParseEnumTypeExtension() (def *ast.EnumTypeExtension, err error
 		}
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, nil
 }
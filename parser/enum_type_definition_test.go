@@ -1,10 +1,8 @@
 package parser
 
 import (
-	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
-	"reflect"
-	"strings"
+	"github.com/google/go-cmp/cmp"
 	"testing"
 )
 
@@ -121,15 +119,13 @@ enum UserKind {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &parser{
-				lexer: gogqllexer.New(strings.NewReader(tt.schema)),
-			}
+			p := newParser(&ast.Source{Body: tt.schema})
 			gotDef, err := p.ParseEnumTypeDefinition(tt.args.description)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseEnumTypeDefinition() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(gotDef, tt.wantDef) {
+			if !cmp.Equal(gotDef, tt.wantDef, ignorePosition) {
 				t.Errorf("ParseEnumTypeDefinition() gotDef = %v, want %v", gotDef, tt.wantDef)
 			}
 		})
@@ -189,15 +185,13 @@ enum RestaurantKind
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &parser{
-				lexer: gogqllexer.New(strings.NewReader(tt.schema)),
-			}
+			p := newParser(&ast.Source{Body: tt.schema})
 			gotDef, err := p.ParseEnumTypeExtension()
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseEnumTypeExtension() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(gotDef, tt.wantDef) {
+			if !cmp.Equal(gotDef, tt.wantDef, ignorePosition) {
 				t.Errorf("ParseEnumTypeExtension() gotDef = %v, want %v", gotDef, tt.wantDef)
 			}
 		})
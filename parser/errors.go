@@ -0,0 +1,115 @@
+package parser
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqllexer"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"strings"
+)
+
+// SyntaxError reports a token that does not fit the GraphQL grammar.
+type SyntaxError struct {
+	Source *ast.Source
+	Line   int
+	Column int
+
+	// Kind and Value describe the offending token.
+	Kind  gogqllexer.Kind
+	Value string
+
+	// Message describes what was expected, e.g. "expected ':' after field name".
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	var b strings.Builder
+	if e.Source != nil && e.Source.Name != "" {
+		b.WriteString(e.Source.Name)
+		b.WriteString(":")
+	}
+	fmt.Fprintf(&b, "%d:%d: syntax error: %s, found %s", e.Line, e.Column, e.Message, describeToken(e.Kind, e.Value))
+	return b.String()
+}
+
+func kindString(kind gogqllexer.Kind) string {
+	switch kind {
+	case gogqllexer.EOF:
+		return "<EOF>"
+	case gogqllexer.Name:
+		return "Name"
+	case gogqllexer.Bang:
+		return "'!'"
+	case gogqllexer.Dollar:
+		return "'$'"
+	case gogqllexer.Amp:
+		return "'&'"
+	case gogqllexer.ParenL:
+		return "'('"
+	case gogqllexer.ParenR:
+		return "')'"
+	case gogqllexer.Spread:
+		return "'...'"
+	case gogqllexer.Equal:
+		return "'='"
+	case gogqllexer.At:
+		return "'@'"
+	case gogqllexer.Colon:
+		return "':'"
+	case gogqllexer.BracketL:
+		return "'['"
+	case gogqllexer.BracketR:
+		return "']'"
+	case gogqllexer.BraceL:
+		return "'{'"
+	case gogqllexer.BraceR:
+		return "'}'"
+	case gogqllexer.Pipe:
+		return "'|'"
+	case gogqllexer.Int:
+		return "Int"
+	case gogqllexer.Float:
+		return "Float"
+	case gogqllexer.String:
+		return "String"
+	case gogqllexer.BlockString:
+		return "BlockString"
+	default:
+		return "invalid token"
+	}
+}
+
+func describeToken(kind gogqllexer.Kind, value string) string {
+	switch kind {
+	case gogqllexer.Name, gogqllexer.Int, gogqllexer.Float:
+		return fmt.Sprintf("%s %q", kindString(kind), value)
+	default:
+		return kindString(kind)
+	}
+}
+
+// errorAt returns a SyntaxError pointing at t.
+func (p *parser) errorAt(t gogqllexer.Token, format string, args ...any) *SyntaxError {
+	line, column := p.lineColumn(tokenStart(t))
+	return &SyntaxError{
+		Source:  p.src,
+		Line:    line,
+		Column:  column,
+		Kind:    t.Kind,
+		Value:   t.Value,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// unexpected returns a SyntaxError pointing at the next token.
+func (p *parser) unexpected(format string, args ...any) *SyntaxError {
+	return p.errorAt(p.PeekToken(), format, args...)
+}
+
+// withContext appends where the parser was to the message of a SyntaxError,
+// e.g. "expected ':'" becomes "expected ':' after field name".
+func withContext(err error, context string) error {
+	if se, ok := err.(*SyntaxError); ok {
+		se.Message += " " + context
+	}
+	return err
+}
@@ -0,0 +1,127 @@
+package parser
+
+import (
+	"errors"
+	"github.com/Sntree2mi8/gogqllexer"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func TestSyntaxError(t *testing.T) {
+	src := &ast.Source{
+		Name: "schema.graphql",
+	}
+	tests := []struct {
+		name      string
+		body      string
+		want      *SyntaxError
+		wantError string
+	}{
+		{
+			name: "missing colon after field name",
+			body: "type Query {\n  name String\n}\n",
+			want: &SyntaxError{
+				Source:  src,
+				Line:    2,
+				Column:  8,
+				Kind:    gogqllexer.Name,
+				Value:   "String",
+				Message: "expected ':' after field name",
+			},
+			wantError: `schema.graphql:2:8: syntax error: expected ':' after field name, found Name "String"`,
+		},
+		{
+			name: "unknown directive location",
+			body: "directive @d on OBJECT | TYPE\n",
+			want: &SyntaxError{
+				Source:  src,
+				Line:    1,
+				Column:  26,
+				Kind:    gogqllexer.Name,
+				Value:   "TYPE",
+				Message: "expected directive location",
+			},
+			wantError: `schema.graphql:1:26: syntax error: expected directive location, found Name "TYPE"`,
+		},
+		{
+			name: "unexpected end of file",
+			body: "type Query {\n  name: String\n",
+			want: &SyntaxError{
+				Source:  src,
+				Line:    3,
+				Column:  1,
+				Kind:    gogqllexer.EOF,
+				Message: "expected Name",
+			},
+			wantError: `schema.graphql:3:1: syntax error: expected Name, found <EOF>`,
+		},
+		{
+			name: "unknown definition",
+			body: "\n\ntypo Query {}\n",
+			want: &SyntaxError{
+				Source:  src,
+				Line:    3,
+				Column:  1,
+				Kind:    gogqllexer.Name,
+				Value:   "typo",
+				Message: "expected type system definition or extension",
+			},
+			wantError: `schema.graphql:3:1: syntax error: expected type system definition or extension, found Name "typo"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src.Body = tt.body
+			_, err := ParseTypeSystemExtensionDocument(src)
+
+			var got *SyntaxError
+			if !errors.As(err, &got) {
+				t.Fatalf("ParseTypeSystemExtensionDocument() error = %v, want *SyntaxError", err)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("ParseTypeSystemExtensionDocument() error = %+v, want %+v", got, tt.want)
+			}
+			if got.Error() != tt.wantError {
+				t.Errorf("Error() = %s, want %s", got.Error(), tt.wantError)
+			}
+		})
+	}
+}
+
+func TestParseExecutableDocument_SyntaxError(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantError string
+	}{
+		{
+			name:      "type definition",
+			body:      "query { a }\ntype Query { a: Int }\n",
+			wantError: `query.graphql:2:1: syntax error: executable document must not contain type system definition, found Name "type"`,
+		},
+		{
+			name:      "type definition with description",
+			body:      "\"Query\" type Query { a: Int }\n",
+			wantError: `query.graphql:1:1: syntax error: executable document must not contain type system definition, found String`,
+		},
+		{
+			name:      "type extension",
+			body:      "extend type Query { b: Int }\n",
+			wantError: `query.graphql:1:1: syntax error: executable document must not contain type system definition, found Name "extend"`,
+		},
+		{
+			name:      "unknown definition",
+			body:      "[a]\n",
+			wantError: `query.graphql:1:1: syntax error: expected operation or fragment definition, found '['`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseExecutableDocument(&ast.Source{Name: "query.graphql", Body: tt.body})
+			if err == nil || err.Error() != tt.wantError {
+				t.Errorf("ParseExecutableDocument() error = %v, want %s", err, tt.wantError)
+			}
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package parser
 
 import (
-	"fmt"
 	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
 )
@@ -22,7 +21,7 @@ func (p *parser) ParseArgumentsDefinition() (defs []ast.InputValueDefinition, er
 			}
 			defs = append(defs, ivd)
 		} else {
-			return nil, fmt.Errorf("unexpected token %+v", p.PeekToken())
+			return nil, p.unexpected("expected argument definition")
 		}
 
 		if p.SkipIf(gogqllexer.ParenR) {
@@ -30,9 +29,5 @@ func (p *parser) ParseArgumentsDefinition() (defs []ast.InputValueDefinition, er
 		}
 	}
 
-	if len(defs) == 0 {
-		return nil, fmt.Errorf("empty arguments definition")
-	}
-
 	return defs, nil
 }
@@ -33,6 +33,7 @@ func (p *parser) parseFieldDefinition() (d *ast.FieldDefinition, err error) {
 	d = &ast.FieldDefinition{}
 
 	d.Description, _ = p.ReadDescription()
+	start := p.PeekToken()
 
 	if d.Name, err = p.ReadNameValue(); err != nil {
 		return nil, err
@@ -45,26 +46,19 @@ func (p *parser) parseFieldDefinition() (d *ast.FieldDefinition, err error) {
 	}
 
 	if err = p.Skip(gogqllexer.Colon); err != nil {
-		return nil, err
+		return nil, withContext(err, "after field name")
 	}
 
-	if err = p.PeekAndMustBe(
-		[]gogqllexer.Kind{gogqllexer.Name},
-		func(t gogqllexer.Token, advanceLexer func()) error {
-			if d.Type, err = p.parseType(); err != nil {
-				return err
-			}
-			return nil
-		},
-	); err != nil {
+	if d.Type, err = p.parseType(); err != nil {
 		return nil, err
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if d.Directives, err = p.parseDirectives(); err != nil {
+		if d.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	}
 
+	d.Position = p.positionFrom(start)
 	return d, err
 }
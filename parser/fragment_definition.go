@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"github.com/Sntree2mi8/gogqllexer"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+// https://spec.graphql.org/October2021/#FragmentDefinition
+func (p *parser) ParseFragmentDefinition() (def *ast.FragmentDefinition, err error) {
+	start := p.PeekToken()
+	def = &ast.FragmentDefinition{}
+
+	if err = p.SkipKeyword("fragment"); err != nil {
+		return nil, err
+	}
+
+	if p.CheckKeyword("on") {
+		return nil, p.unexpected("expected fragment name other than \"on\"")
+	}
+	if def.Name, err = p.ReadNameValue(); err != nil {
+		return nil, withContext(err, "for fragment name")
+	}
+
+	if err = p.SkipKeyword("on"); err != nil {
+		return nil, withContext(err, "after fragment name")
+	}
+
+	if def.TypeCondition, err = p.ReadNameValue(); err != nil {
+		return nil, err
+	}
+
+	if p.CheckKind(gogqllexer.At) {
+		if def.Directives, err = p.parseDirectives(false); err != nil {
+			return nil, err
+		}
+	}
+
+	if def.SelectionSet, err = p.parseSelectionSet(); err != nil {
+		return nil, err
+	}
+
+	def.Position = p.positionFrom(start)
+	return def, nil
+}
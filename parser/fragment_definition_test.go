@@ -0,0 +1,104 @@
+package parser
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func TestParseFragmentDefinition(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		wantDef  *ast.FragmentDefinition
+		wantErr  bool
+	}{
+		{
+			name: "simple fragment",
+			document: `
+fragment UserFields on User {
+	id
+	friends(first: 10) {
+		...FriendFields
+	}
+}
+`,
+			wantDef: &ast.FragmentDefinition{
+				Name:          "UserFields",
+				TypeCondition: "User",
+				SelectionSet: ast.SelectionSet{
+					&ast.Field{
+						Name: "id",
+					},
+					&ast.Field{
+						Name: "friends",
+						Arguments: []ast.Argument{
+							{
+								Name:  "first",
+								Value: ast.IntValue{Value: 10},
+							},
+						},
+						SelectionSet: ast.SelectionSet{
+							&ast.FragmentSpread{
+								FragmentName: "FriendFields",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "with directive",
+			document: `
+fragment UserFields on User @fragment_directive {
+	id
+}
+`,
+			wantDef: &ast.FragmentDefinition{
+				Name:          "UserFields",
+				TypeCondition: "User",
+				Directives: []ast.Directive{
+					{
+						Name: "fragment_directive",
+					},
+				},
+				SelectionSet: ast.SelectionSet{
+					&ast.Field{
+						Name: "id",
+					},
+				},
+			},
+		},
+		{
+			name: "fragment name must not be on",
+			document: `
+fragment on on User {
+	id
+}
+`,
+			wantErr: true,
+		},
+		{
+			name: "type condition is required",
+			document: `
+fragment UserFields {
+	id
+}
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newParser(&ast.Source{Body: tt.document})
+			gotDef, err := p.ParseFragmentDefinition()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseFragmentDefinition() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !cmp.Equal(gotDef, tt.wantDef, ignorePosition) {
+				t.Errorf("ParseFragmentDefinition() gotDef = %v, want %v", gotDef, tt.wantDef)
+			}
+		})
+	}
+}
@@ -1,19 +1,19 @@
 package parser
 
 import (
-	"fmt"
 	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
 )
 
 func (p *parser) parseInputValueDefinition(description string) (def ast.InputValueDefinition, err error) {
+	start := p.PeekToken()
 	def.Description = description
 	if def.Name, err = p.ReadNameValue(); err != nil {
 		return def, err
 	}
 
 	if err = p.Skip(gogqllexer.Colon); err != nil {
-		return def, err
+		return def, withContext(err, "after input value name")
 	}
 
 	def.Type, err = p.parseType()
@@ -22,25 +22,18 @@ func (p *parser) parseInputValueDefinition(description string) (def ast.InputVal
 	}
 
 	if p.SkipIf(gogqllexer.Equal) {
-		if err = p.PeekAndMustBe(
-			[]gogqllexer.Kind{gogqllexer.Int, gogqllexer.Float, gogqllexer.String, gogqllexer.BlockString, gogqllexer.Name},
-			func(t gogqllexer.Token, advanceLexer func()) error {
-				defer advanceLexer()
-
-				def.RawDefaultValue = t.Value
-				return nil
-			},
-		); err != nil {
+		if def.DefaultValue, def.RawDefaultValue, err = p.parseDefaultValue(); err != nil {
 			return def, err
 		}
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return def, err
 		}
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, nil
 }
 
@@ -69,6 +62,7 @@ func (p *parser) parseInputFieldsDefinition() (defs []ast.InputValueDefinition,
 
 // https://spec.graphql.org/October2021/#sec-Input-Objects
 func (p *parser) ParseInputObjectTypeDefinition(description string) (def *ast.InputObjectTypeDefinition, err error) {
+	start := p.PeekToken()
 	def = &ast.InputObjectTypeDefinition{
 		Description: description,
 	}
@@ -82,7 +76,7 @@ func (p *parser) ParseInputObjectTypeDefinition(description string) (def *ast.In
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	}
@@ -91,6 +85,7 @@ func (p *parser) ParseInputObjectTypeDefinition(description string) (def *ast.In
 		return nil, err
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, nil
 }
 
@@ -99,6 +94,7 @@ func (p *parser) ParseInputObjectTypeDefinition(description string) (def *ast.In
 //
 // Reference: https://spec.graphql.org/October2021/#sec-Input-Object-Extensions
 func (p *parser) ParseInputObjectTypeExtension() (def *ast.InputObjectTypeExtension, err error) {
+	start := p.PeekToken()
 	def = &ast.InputObjectTypeExtension{}
 
 	if err = p.SkipKeyword("input"); err != nil {
@@ -111,7 +107,7 @@ func (p *parser) ParseInputObjectTypeExtension() (def *ast.InputObjectTypeExtens
 
 	var hasDirective bool
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 
@@ -124,10 +120,9 @@ func (p *parser) ParseInputObjectTypeExtension() (def *ast.InputObjectTypeExtens
 			return nil, err
 		}
 	} else if !hasDirective {
-		// TODO: fix error message
-		// if next token is punctuator, there is no value to print.
-		return nil, fmt.Errorf("expected '{' or '@' but got %s", p.PeekToken().Value)
+		return nil, p.unexpected("expected '@' or '{' in input object type extension")
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, nil
 }
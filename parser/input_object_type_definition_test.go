@@ -1,10 +1,8 @@
 package parser
 
 import (
-	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
-	"reflect"
-	"strings"
+	"github.com/google/go-cmp/cmp"
 	"testing"
 )
 
@@ -109,18 +107,78 @@ input User {
 				},
 			},
 		},
+		{
+			name: "with default values",
+			schema: `
+input Query {
+	filter: Filter = {limit: 10, order: DESC}
+	ids: [ID!] = []
+	name: String = "name"
+}
+`,
+			wantDef: &ast.InputObjectTypeDefinition{
+				Name: "Query",
+				InputFields: []ast.InputValueDefinition{
+					{
+						Name: "filter",
+						Type: ast.Type{
+							NamedType: "Filter",
+						},
+						DefaultValue: ast.ObjectValue{
+							Fields: []ast.ObjectField{
+								{
+									Name:  "limit",
+									Value: ast.IntValue{Value: 10},
+								},
+								{
+									Name:  "order",
+									Value: ast.EnumValue{Value: "DESC"},
+								},
+							},
+						},
+						RawDefaultValue: "{limit: 10, order: DESC}",
+					},
+					{
+						Name: "ids",
+						Type: ast.Type{
+							ListType: &ast.Type{
+								NamedType: "ID",
+								NotNull:   true,
+							},
+						},
+						DefaultValue:    ast.ListValue{},
+						RawDefaultValue: "[]",
+					},
+					{
+						Name: "name",
+						Type: ast.Type{
+							NamedType: "String",
+						},
+						DefaultValue:    ast.StringValue{Value: "name"},
+						RawDefaultValue: `"name"`,
+					},
+				},
+			},
+		},
+		{
+			name: "default value must be constant",
+			schema: `
+input Query {
+	limit: Int = $limit
+}
+`,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &parser{
-				lexer: gogqllexer.New(strings.NewReader(tt.schema)),
-			}
+			p := newParser(&ast.Source{Body: tt.schema})
 			gotDef, err := p.ParseInputObjectTypeDefinition(tt.args.description)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseInputObjectTypeDefinition() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(gotDef, tt.wantDef) {
+			if !cmp.Equal(gotDef, tt.wantDef, ignorePosition) {
 				t.Errorf("ParseInputObjectTypeDefinition() gotDef = %v, want %v", gotDef, tt.wantDef)
 			}
 		})
@@ -207,15 +265,13 @@ type OtherType {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &parser{
-				lexer: gogqllexer.New(strings.NewReader(tt.schema)),
-			}
+			p := newParser(&ast.Source{Body: tt.schema})
 			gotDef, err := p.ParseInputObjectTypeExtension()
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseInputObjectTypeExtension() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(gotDef, tt.wantDef) {
+			if !cmp.Equal(gotDef, tt.wantDef, ignorePosition) {
 				t.Errorf("ParseInputObjectTypeExtension() gotDef = %v, want %v", gotDef, tt.wantDef)
 			}
 		})
@@ -7,6 +7,7 @@ import (
 
 // https://spec.graphql.org/October2021/#sec-Interfaces
 func (p *parser) ParseInterfaceTypeDefinition(description string) (d *ast.InterfaceTypeDefinition, err error) {
+	start := p.PeekToken()
 	d = &ast.InterfaceTypeDefinition{}
 
 	d.Description = description
@@ -26,7 +27,7 @@ func (p *parser) ParseInterfaceTypeDefinition(description string) (d *ast.Interf
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if d.Directives, err = p.parseDirectives(); err != nil {
+		if d.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	}
@@ -43,6 +44,7 @@ func (p *parser) ParseInterfaceTypeDefinition(description string) (d *ast.Interf
 		return nil, err
 	}
 
+	d.Position = p.positionFrom(start)
 	return d, err
 }
 
@@ -51,6 +53,7 @@ func (p *parser) ParseInterfaceTypeDefinition(description string) (d *ast.Interf
 //
 // Reference: https://spec.graphqp.org/October2021/#sec-Interface-Extensions
 func (p *parser) ParseInterfaceTypeExtension() (def *ast.InterfaceTypeExtension, err error) {
+	start := p.PeekToken()
 	def = &ast.InterfaceTypeExtension{}
 
 	if err = p.SkipKeyword("interface"); err != nil {
@@ -68,7 +71,7 @@ func (p *parser) ParseInterfaceTypeExtension() (def *ast.InterfaceTypeExtension,
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	}
@@ -79,5 +82,6 @@ func (p *parser) ParseInterfaceTypeExtension() (def *ast.InterfaceTypeExtension,
 		}
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, nil
 }
@@ -1,10 +1,8 @@
 package parser
 
 import (
-	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
-	"reflect"
-	"strings"
+	"github.com/google/go-cmp/cmp"
 	"testing"
 )
 
@@ -72,7 +70,7 @@ interface User @deprecated(reason: "this is deprecated") {
 						Arguments: []ast.Argument{
 							{
 								Name:  "reason",
-								Value: "\"this is deprecated\"",
+								Value: ast.StringValue{Value: "this is deprecated"},
 							},
 						},
 					},
@@ -116,7 +114,7 @@ interface User implements Node @deprecated(reason: "this is deprecated") {
 						Arguments: []ast.Argument{
 							{
 								Name:  "reason",
-								Value: "\"this is deprecated\"",
+								Value: ast.StringValue{Value: "this is deprecated"},
 							},
 						},
 					},
@@ -142,15 +140,13 @@ interface User implements Node @deprecated(reason: "this is deprecated") {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &parser{
-				lexer: gogqllexer.New(strings.NewReader(tt.schema)),
-			}
+			p := newParser(&ast.Source{Body: tt.schema})
 			gotD, err := p.ParseInterfaceTypeDefinition(tt.args.description)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseInterfaceTypeDefinition() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(gotD, tt.wantD) {
+			if !cmp.Equal(gotD, tt.wantD, ignorePosition) {
 				t.Errorf("ParseInterfaceTypeDefinition() gotD = %v, want %v", gotD, tt.wantD)
 			}
 		})
@@ -220,15 +216,13 @@ interface RestaurantInterface
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &parser{
-				lexer: gogqllexer.New(strings.NewReader(tt.schema)),
-			}
+			p := newParser(&ast.Source{Body: tt.schema})
 			gotDef, err := p.ParseInterfaceTypeExtension()
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseInterfaceTypeExtension() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(gotDef, tt.wantDef) {
+			if !cmp.Equal(gotDef, tt.wantDef, ignorePosition) {
 				t.Errorf("ParseInterfaceTypeExtension() gotDef = %v, want %v", gotDef, tt.wantDef)
 			}
 		})
@@ -1,13 +1,13 @@
 package parser
 
 import (
-	"fmt"
 	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
 )
 
 // https://spec.graphql.org/October2021/#sec-Objects
 func (p *parser) ParseObjectTypeDefinition(description string) (d *ast.ObjectTypeDefinition, err error) {
+	start := p.PeekToken()
 	d = &ast.ObjectTypeDefinition{
 		Description: description,
 	}
@@ -27,7 +27,7 @@ func (p *parser) ParseObjectTypeDefinition(description string) (d *ast.ObjectTyp
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if d.Directives, err = p.parseDirectives(); err != nil {
+		if d.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	}
@@ -44,6 +44,7 @@ func (p *parser) ParseObjectTypeDefinition(description string) (d *ast.ObjectTyp
 		return nil, err
 	}
 
+	d.Position = p.positionFrom(start)
 	return d, nil
 }
 
@@ -52,6 +53,7 @@ func (p *parser) ParseObjectTypeDefinition(description string) (d *ast.ObjectTyp
 //
 // Reference: https://spec.graphql.org/October2021/#sec-Object-Extensions
 func (p *parser) ParseObjectTypeExtension() (def *ast.ObjectTypeExtension, err error) {
+	start := p.PeekToken()
 	def = &ast.ObjectTypeExtension{}
 
 	if err = p.SkipKeyword("type"); err != nil {
@@ -72,7 +74,7 @@ func (p *parser) ParseObjectTypeExtension() (def *ast.ObjectTypeExtension, err e
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 
@@ -84,8 +86,9 @@ func (p *parser) ParseObjectTypeExtension() (def *ast.ObjectTypeExtension, err e
 			return nil, err
 		}
 	} else if !canOmitFields {
-		return nil, fmt.Errorf("unexpected token. expected interface implementation or directive or fields definition")
+		return nil, p.unexpected("expected \"implements\", '@' or '{' in object type extension")
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, nil
 }
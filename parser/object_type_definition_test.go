@@ -1,10 +1,8 @@
 package parser
 
 import (
-	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
-	"reflect"
-	"strings"
+	"github.com/google/go-cmp/cmp"
 	"testing"
 )
 
@@ -138,7 +136,7 @@ type User @role(role: "admin") {
 						Arguments: []ast.Argument{
 							{
 								Name:  "role",
-								Value: "\"admin\"",
+								Value: ast.StringValue{Value: "admin"},
 							},
 						},
 					},
@@ -161,18 +159,39 @@ type User @role(role: "admin") {
 				},
 			},
 		},
+		{
+			name: "object with list field",
+			schema: `
+type User {
+	friends: [User!]!
+}
+`,
+			wantD: &ast.ObjectTypeDefinition{
+				Name: "User",
+				FieldDefinitions: []*ast.FieldDefinition{
+					{
+						Name: "friends",
+						Type: ast.Type{
+							ListType: &ast.Type{
+								NamedType: "User",
+								NotNull:   true,
+							},
+							NotNull: true,
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &parser{
-				lexer: gogqllexer.New(strings.NewReader(tt.schema)),
-			}
+			p := newParser(&ast.Source{Body: tt.schema})
 			gotD, err := p.ParseObjectTypeDefinition(tt.args.description)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseObjectTypeDefinition() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(gotD, tt.wantD) {
+			if !cmp.Equal(gotD, tt.wantD, ignorePosition) {
 				t.Errorf("ParseObjectTypeDefinition() gotD = %v, want %v", gotD, tt.wantD)
 			}
 		})
@@ -288,15 +307,13 @@ type Restaurant implements Store
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &parser{
-				lexer: gogqllexer.New(strings.NewReader(tt.schema)),
-			}
+			p := newParser(&ast.Source{Body: tt.schema})
 			gotDef, err := p.ParseObjectTypeExtension()
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseObjectTypeExtension() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(gotDef, tt.wantDef) {
+			if !cmp.Equal(gotDef, tt.wantDef, ignorePosition) {
 				t.Errorf("ParseObjectTypeExtension() gotDef = %v, want %v", gotDef, tt.wantDef)
 			}
 		})
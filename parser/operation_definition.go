@@ -0,0 +1,112 @@
+package parser
+
+import (
+	"github.com/Sntree2mi8/gogqllexer"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+func parseOperationType(v string) (ast.OperationType, bool) {
+	switch v {
+	case "query":
+		return ast.OperationTypeQuery, true
+	case "mutation":
+		return ast.OperationTypeMutation, true
+	case "subscription":
+		return ast.OperationTypeSubscription, true
+	default:
+		return 0, false
+	}
+}
+
+// https://spec.graphql.org/October2021/#VariableDefinitions
+func (p *parser) parseVariableDefinitions() (defs []ast.VariableDefinition, err error) {
+	if err = p.Skip(gogqllexer.ParenL); err != nil {
+		return nil, err
+	}
+
+	for {
+		var def ast.VariableDefinition
+
+		start := p.PeekToken()
+		if err = p.Skip(gogqllexer.Dollar); err != nil {
+			return nil, err
+		}
+		if def.Variable, err = p.ReadNameValue(); err != nil {
+			return nil, err
+		}
+		if err = p.Skip(gogqllexer.Colon); err != nil {
+			return nil, withContext(err, "after variable name")
+		}
+		if def.Type, err = p.parseType(); err != nil {
+			return nil, err
+		}
+
+		if p.SkipIf(gogqllexer.Equal) {
+			if def.DefaultValue, def.RawDefaultValue, err = p.parseDefaultValue(); err != nil {
+				return nil, err
+			}
+		}
+
+		if p.CheckKind(gogqllexer.At) {
+			if def.Directives, err = p.parseDirectives(true); err != nil {
+				return nil, err
+			}
+		}
+
+		def.Position = p.positionFrom(start)
+		defs = append(defs, def)
+
+		if p.SkipIf(gogqllexer.ParenR) {
+			break
+		}
+	}
+
+	return defs, nil
+}
+
+// ParseOperationDefinition parses an operation definition.
+// A selection set without operation type is parsed as a query shorthand.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Language.Operations
+func (p *parser) ParseOperationDefinition() (def *ast.OperationDefinition, err error) {
+	start := p.PeekToken()
+	def = &ast.OperationDefinition{}
+
+	if p.CheckKind(gogqllexer.BraceL) {
+		def.OperationType = ast.OperationTypeQuery
+		if def.SelectionSet, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+		def.Position = p.positionFrom(start)
+		return def, nil
+	}
+
+	t := p.NextToken()
+	var ok bool
+	if def.OperationType, ok = parseOperationType(t.Value); t.Kind != gogqllexer.Name || !ok {
+		return nil, p.errorAt(t, "expected \"query\", \"mutation\", \"subscription\" or '{'")
+	}
+
+	if p.CheckKind(gogqllexer.Name) {
+		def.Name, _ = p.ReadNameValue()
+	}
+
+	if p.CheckKind(gogqllexer.ParenL) {
+		if def.VariableDefinitions, err = p.parseVariableDefinitions(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.CheckKind(gogqllexer.At) {
+		if def.Directives, err = p.parseDirectives(false); err != nil {
+			return nil, err
+		}
+	}
+
+	if def.SelectionSet, err = p.parseSelectionSet(); err != nil {
+		return nil, err
+	}
+
+	def.Position = p.positionFrom(start)
+	return def, nil
+}
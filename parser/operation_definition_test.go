@@ -0,0 +1,231 @@
+package parser
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func TestParseOperationDefinition(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		wantDef  *ast.OperationDefinition
+		wantErr  bool
+	}{
+		{
+			name: "query shorthand",
+			document: `
+{
+	viewer {
+		name
+	}
+}
+`,
+			wantDef: &ast.OperationDefinition{
+				OperationType: ast.OperationTypeQuery,
+				SelectionSet: ast.SelectionSet{
+					&ast.Field{
+						Name: "viewer",
+						SelectionSet: ast.SelectionSet{
+							&ast.Field{
+								Name: "name",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "named query with variables and directives",
+			document: `
+query GetUser($id: ID!, $withName: Boolean = true) @operation_directive {
+	user(id: $id) {
+		id
+		name @include(if: $withName)
+	}
+}
+`,
+			wantDef: &ast.OperationDefinition{
+				OperationType: ast.OperationTypeQuery,
+				Name:          "GetUser",
+				VariableDefinitions: []ast.VariableDefinition{
+					{
+						Variable: "id",
+						Type: ast.Type{
+							NamedType: "ID",
+							NotNull:   true,
+						},
+					},
+					{
+						Variable: "withName",
+						Type: ast.Type{
+							NamedType: "Boolean",
+						},
+						DefaultValue:    ast.BooleanValue{Value: true},
+						RawDefaultValue: "true",
+					},
+				},
+				Directives: []ast.Directive{
+					{
+						Name: "operation_directive",
+					},
+				},
+				SelectionSet: ast.SelectionSet{
+					&ast.Field{
+						Name: "user",
+						Arguments: []ast.Argument{
+							{
+								Name:  "id",
+								Value: ast.Variable{Name: "id"},
+							},
+						},
+						SelectionSet: ast.SelectionSet{
+							&ast.Field{
+								Name: "id",
+							},
+							&ast.Field{
+								Name: "name",
+								Directives: []ast.Directive{
+									{
+										Name: "include",
+										Arguments: []ast.Argument{
+											{
+												Name:  "if",
+												Value: ast.Variable{Name: "withName"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "mutation with alias",
+			document: `
+mutation {
+	created: createUser(name: "name") {
+		id
+	}
+}
+`,
+			wantDef: &ast.OperationDefinition{
+				OperationType: ast.OperationTypeMutation,
+				SelectionSet: ast.SelectionSet{
+					&ast.Field{
+						Alias: "created",
+						Name:  "createUser",
+						Arguments: []ast.Argument{
+							{
+								Name:  "name",
+								Value: ast.StringValue{Value: "name"},
+							},
+						},
+						SelectionSet: ast.SelectionSet{
+							&ast.Field{
+								Name: "id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "subscription with fragments",
+			document: `
+subscription OnEvent {
+	event {
+		...EventFields
+		... on Message {
+			body
+		}
+		... @include(if: true) {
+			id
+		}
+	}
+}
+`,
+			wantDef: &ast.OperationDefinition{
+				OperationType: ast.OperationTypeSubscription,
+				Name:          "OnEvent",
+				SelectionSet: ast.SelectionSet{
+					&ast.Field{
+						Name: "event",
+						SelectionSet: ast.SelectionSet{
+							&ast.FragmentSpread{
+								FragmentName: "EventFields",
+							},
+							&ast.InlineFragment{
+								TypeCondition: "Message",
+								SelectionSet: ast.SelectionSet{
+									&ast.Field{
+										Name: "body",
+									},
+								},
+							},
+							&ast.InlineFragment{
+								Directives: []ast.Directive{
+									{
+										Name: "include",
+										Arguments: []ast.Argument{
+											{
+												Name:  "if",
+												Value: ast.BooleanValue{Value: true},
+											},
+										},
+									},
+								},
+								SelectionSet: ast.SelectionSet{
+									&ast.Field{
+										Name: "id",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "unknown operation type",
+			document: `
+fetch {
+	id
+}
+`,
+			wantErr: true,
+		},
+		{
+			name: "empty selection set",
+			document: `
+query {}
+`,
+			wantErr: true,
+		},
+		{
+			name: "variable in variable default value",
+			document: `
+query ($a: Int = $b) {
+	id
+}
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newParser(&ast.Source{Body: tt.document})
+			gotDef, err := p.ParseOperationDefinition()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseOperationDefinition() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !cmp.Equal(gotDef, tt.wantDef, ignorePosition) {
+				t.Errorf("ParseOperationDefinition() gotDef = %v, want %v", gotDef, tt.wantDef)
+			}
+		})
+	}
+}
@@ -1,32 +1,154 @@
 package parser
 
 import (
-	"fmt"
+	"errors"
 	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
 	"slices"
+	"sort"
 	"strings"
+	"unicode/utf8"
 )
 
 type parser struct {
+	src   *ast.Source
 	lexer *gogqllexer.Lexer
 
 	keepToken *gogqllexer.Token
+	// lexOffset is the byte offset of src the lexer started at, see restartAt.
+	lexOffset int
+
+	// lastEnd is the byte offset just after the last consumed token.
+	lastEnd int
+	// lineStarts holds the byte offset at which each line of src begins.
+	lineStarts []int
+
+	// openBrackets holds the brackets, braces and parentheses opened by consumed tokens and not yet closed.
+	openBrackets []gogqllexer.Kind
+}
+
+func newParser(src *ast.Source) *parser {
+	return &parser{
+		src:        src,
+		lexer:      gogqllexer.New(strings.NewReader(src.Body)),
+		lineStarts: lineStarts(src.Body),
+	}
+}
+
+func lineStarts(body string) []int {
+	starts := []int{0}
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '\r':
+			if i+1 < len(body) && body[i+1] == '\n' {
+				i++
+			}
+			starts = append(starts, i+1)
+		case '\n':
+			starts = append(starts, i+1)
+		}
+	}
+	return starts
+}
+
+// tokenStart returns the byte offset at which t begins.
+// gogqllexer reports 1-based offsets except for EOF.
+func tokenStart(t gogqllexer.Token) int {
+	if t.Kind == gogqllexer.EOF {
+		return t.Position.Start
+	}
+	return t.Position.Start - 1
+}
+
+// tokenEnd returns the byte offset just after t.
+func tokenEnd(t gogqllexer.Token) int {
+	switch t.Kind {
+	case gogqllexer.EOF, gogqllexer.Invalid:
+		return tokenStart(t)
+	case gogqllexer.Spread:
+		return tokenStart(t) + 3
+	case gogqllexer.Name, gogqllexer.Int, gogqllexer.Float, gogqllexer.String, gogqllexer.BlockString:
+		return tokenStart(t) + len(t.Value)
+	default:
+		return tokenStart(t) + 1
+	}
+}
+
+// lineColumn converts a byte offset into a 1-based line and column.
+// The column counts runes, not bytes.
+func (p *parser) lineColumn(offset int) (line int, column int) {
+	line = sort.Search(len(p.lineStarts), func(i int) bool {
+		return p.lineStarts[i] > offset
+	})
+	lineStart := p.lineStarts[line-1]
+	if offset > len(p.src.Body) {
+		offset = len(p.src.Body)
+	}
+	return line, utf8.RuneCountInString(p.src.Body[lineStart:offset]) + 1
+}
+
+// positionFrom returns the position spanning from start to the last consumed token.
+func (p *parser) positionFrom(start gogqllexer.Token) *ast.Position {
+	pos := &ast.Position{
+		Source: p.src,
+		Start:  tokenStart(start),
+		End:    p.lastEnd,
+	}
+	pos.Line, pos.Column = p.lineColumn(pos.Start)
+	return pos
 }
 
 func (p *parser) NextToken() gogqllexer.Token {
+	var t gogqllexer.Token
 	if p.keepToken != nil {
-		t := *p.keepToken
+		t = *p.keepToken
 		p.keepToken = nil
-		return t
+	} else {
+		t = p.lex()
+	}
+
+	p.lastEnd = tokenEnd(t)
+	p.trackBrackets(t.Kind)
+	return t
+}
+
+// trackBrackets keeps openBrackets up to date.
+// A closing token also closes the unclosed tokens opened after its counterpart, so that
+// a mismatch such as "[id)" does not leave the parser nested forever.
+func (p *parser) trackBrackets(kind gogqllexer.Kind) {
+	var opening gogqllexer.Kind
+	switch kind {
+	case gogqllexer.BraceL, gogqllexer.BracketL, gogqllexer.ParenL:
+		p.openBrackets = append(p.openBrackets, kind)
+		return
+	case gogqllexer.BraceR:
+		opening = gogqllexer.BraceL
+	case gogqllexer.BracketR:
+		opening = gogqllexer.BracketL
+	case gogqllexer.ParenR:
+		opening = gogqllexer.ParenL
+	default:
+		return
+	}
+
+	for i := len(p.openBrackets) - 1; i >= 0; i-- {
+		if p.openBrackets[i] == opening {
+			p.openBrackets = p.openBrackets[:i]
+			return
+		}
 	}
+}
 
-	return p.lexer.NextToken()
+// lex returns the next token of the lexer, with its offset in src.
+func (p *parser) lex() gogqllexer.Token {
+	t := p.lexer.NextToken()
+	t.Position.Start += p.lexOffset
+	return t
 }
 
 func (p *parser) PeekToken() gogqllexer.Token {
 	if p.keepToken == nil {
-		t := p.lexer.NextToken()
+		t := p.lex()
 		p.keepToken = &t
 	}
 
@@ -38,13 +160,18 @@ func (p *parser) PeekAndMustBe(kinds []gogqllexer.Kind, callback func(t gogqllex
 	if slices.Contains(kinds, t.Kind) {
 		return callback(t, func() { p.NextToken() })
 	}
-	return fmt.Errorf("unexpected token %v", t)
+
+	expected := make([]string, len(kinds))
+	for i, kind := range kinds {
+		expected[i] = kindString(kind)
+	}
+	return p.errorAt(t, "expected %s", strings.Join(expected, " or "))
 }
 
 func (p *parser) Skip(kind gogqllexer.Kind) error {
 	t := p.NextToken()
 	if t.Kind != kind {
-		return fmt.Errorf("unexpected token %v", t)
+		return p.errorAt(t, "expected %s", kindString(kind))
 	}
 	return nil
 }
@@ -81,7 +208,7 @@ func (p *parser) CheckKeyword(keyword string) bool {
 func (p *parser) ReadNameValue() (string, error) {
 	t := p.NextToken()
 	if t.Kind != gogqllexer.Name {
-		return "", fmt.Errorf("unexpected token %v", t)
+		return "", p.errorAt(t, "expected Name")
 	}
 	return t.Value, nil
 }
@@ -89,7 +216,7 @@ func (p *parser) ReadNameValue() (string, error) {
 func (p *parser) SkipKeyword(keyword string) error {
 	t := p.NextToken()
 	if t.Kind != gogqllexer.Name || t.Value != keyword {
-		return fmt.Errorf("unexpected token %v", t)
+		return p.errorAt(t, "expected %q", keyword)
 	}
 	return nil
 }
@@ -108,128 +235,267 @@ func ParseTypeSystemExtensionDocument(src *ast.Source) (doc *ast.TypeSystemExten
 	doc = &ast.TypeSystemExtensionDocument{
 		TypeDefinitions: []ast.TypeDefinition{},
 	}
-	p := &parser{
-		lexer: gogqllexer.New(strings.NewReader(src.Body)),
+	p := newParser(src)
+
+	for !p.CheckKind(gogqllexer.EOF) {
+		if err = p.parseTypeSystemDefinition(doc); err != nil {
+			return nil, err
+		}
 	}
 
-	for {
-		description, _ := p.ReadDescription()
+	return doc, nil
+}
 
+// ParseTypeSystemExtensionDocumentWithRecovery parses src like ParseTypeSystemExtensionDocument,
+// but does not stop at the first syntax error.
+// It skips to the next top-level definition instead, and returns the definitions that could be parsed
+// together with every syntax error found in src.
+func ParseTypeSystemExtensionDocumentWithRecovery(src *ast.Source) (doc *ast.TypeSystemExtensionDocument, errs []*SyntaxError) {
+	doc = &ast.TypeSystemExtensionDocument{
+		TypeDefinitions: []ast.TypeDefinition{},
+	}
+	p := newParser(src)
+
+	for !p.CheckKind(gogqllexer.EOF) {
+		start := p.PeekToken()
+		if err := p.parseTypeSystemDefinition(doc); err != nil {
+			var se *SyntaxError
+			if !errors.As(err, &se) {
+				se = p.unexpected("%s", err)
+			}
+			errs = append(errs, se)
+			if !p.synchronize(start) {
+				break
+			}
+		}
+	}
+
+	return doc, errs
+}
+
+func isTypeSystemDefinitionStart(t gogqllexer.Token) bool {
+	switch t.Kind {
+	case gogqllexer.String, gogqllexer.BlockString:
+		return true
+	case gogqllexer.Name:
+		switch t.Value {
+		case "type", "interface", "union", "enum", "input", "scalar", "directive", "schema", "extend":
+			return true
+		}
+	}
+	return false
+}
+
+// synchronize skips to the next definition after start, the first token of the definition that failed to parse.
+// The next definition begins with a description or a definition keyword which appears outside of any brackets
+// or at the start of a line, so that an unclosed bracket does not swallow the rest of the document.
+// As the failed definition may have consumed such a token, the search restarts from start.
+// Invalid tokens, such as an unterminated string, are skipped. It reports false when an invalid token blocks the lexer,
+// that is when the lexer does not move past it, since nothing after it can be read.
+func (p *parser) synchronize(start gogqllexer.Token) bool {
+	p.restartAt(start)
+	// move past the description, "extend" and keyword the failed definition begins with.
+	if p.CheckKind(gogqllexer.String) || p.CheckKind(gogqllexer.BlockString) {
+		p.NextToken()
+	}
+	p.SkipKeywordIf("extend")
+	p.NextToken()
+
+	for {
 		t := p.PeekToken()
-		if t.Kind == gogqllexer.EOF {
-			break
+		switch {
+		case t.Kind == gogqllexer.EOF:
+			return true
+		case t.Kind == gogqllexer.Invalid:
+			p.NextToken()
+			if tokenStart(p.PeekToken()) == tokenStart(t) {
+				return false
+			}
+			continue
+		case isTypeSystemDefinitionStart(t) && (len(p.openBrackets) == 0 || p.isLineStart(t)):
+			p.openBrackets = nil
+			return true
 		}
-		if t.Kind != gogqllexer.Name {
-			return nil, fmt.Errorf("unexpected token %+v", t)
+		p.NextToken()
+	}
+}
+
+// restartAt lexes src again from t, which becomes the next token.
+func (p *parser) restartAt(t gogqllexer.Token) {
+	p.lexOffset = tokenStart(t)
+	p.lexer = gogqllexer.New(strings.NewReader(p.src.Body[p.lexOffset:]))
+	p.openBrackets = nil
+	p.lastEnd = p.lexOffset
+	next := p.lex()
+	p.keepToken = &next
+}
+
+// isLineStart reports whether t is at the first column of its line.
+func (p *parser) isLineStart(t gogqllexer.Token) bool {
+	_, column := p.lineColumn(tokenStart(t))
+	return column == 1
+}
+
+// parseTypeSystemDefinition parses one type system definition or extension and adds it to doc.
+func (p *parser) parseTypeSystemDefinition(doc *ast.TypeSystemExtensionDocument) error {
+	description, _ := p.ReadDescription()
+
+	t := p.PeekToken()
+	if t.Kind != gogqllexer.Name {
+		return p.errorAt(t, "expected type system definition or extension")
+	}
+
+	switch t.Value {
+	case "type":
+		def, err := p.ParseObjectTypeDefinition(description)
+		if err != nil {
+			return err
+		}
+		doc.TypeDefinitions = append(doc.TypeDefinitions, def)
+	case "interface":
+		def, err := p.ParseInterfaceTypeDefinition(description)
+		if err != nil {
+			return err
+		}
+		doc.TypeDefinitions = append(doc.TypeDefinitions, def)
+	case "union":
+		def, err := p.ParseUnionTypeDefinition(description)
+		if err != nil {
+			return err
+		}
+		doc.TypeDefinitions = append(doc.TypeDefinitions, def)
+	case "enum":
+		def, err := p.ParseEnumTypeDefinition(description)
+		if err != nil {
+			return err
+		}
+		doc.TypeDefinitions = append(doc.TypeDefinitions, def)
+	case "input":
+		def, err := p.ParseInputObjectTypeDefinition(description)
+		if err != nil {
+			return err
+		}
+		doc.TypeDefinitions = append(doc.TypeDefinitions, def)
+	case "scalar":
+		def, err := p.ParseScalarTypeDefinition(description)
+		if err != nil {
+			return err
+		}
+		doc.TypeDefinitions = append(doc.TypeDefinitions, def)
+	case "directive":
+		directiveDefinition, err := p.ParseDirectiveDefinition(description)
+		if err != nil {
+			return err
+		}
+		doc.DirectiveDefinitions = append(doc.DirectiveDefinitions, *directiveDefinition)
+	case "schema":
+		schemaDef, err := p.ParseSchemaDefinition(description)
+		if err != nil {
+			return err
+		}
+		doc.SchemaDefinitions = append(doc.SchemaDefinitions, *schemaDef)
+	case "extend":
+		// extension positions start at the "extend" keyword.
+		if err := p.SkipKeyword("extend"); err != nil {
+			return err
 		}
 
-		switch t.Value {
+		// each Parse*Extension consumes its own keyword, so only peek it here.
+		keyword := p.PeekToken()
+		switch keyword.Value {
 		case "type":
-			def, err := p.ParseObjectTypeDefinition(description)
+			def, err := p.ParseObjectTypeExtension()
 			if err != nil {
-				return nil, err
+				return err
 			}
-			doc.TypeDefinitions = append(doc.TypeDefinitions, def)
+			def.Position = p.positionFrom(t)
+			doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
 		case "interface":
-			def, err := p.ParseInterfaceTypeDefinition(description)
+			def, err := p.ParseInterfaceTypeExtension()
 			if err != nil {
-				return nil, err
+				return err
 			}
-			doc.TypeDefinitions = append(doc.TypeDefinitions, def)
+			def.Position = p.positionFrom(t)
+			doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
 		case "union":
-			def, err := p.ParseUnionTypeDefinition(description)
+			def, err := p.ParseUnionTypeExtension()
 			if err != nil {
-				return nil, err
+				return err
 			}
-			doc.TypeDefinitions = append(doc.TypeDefinitions, def)
+			def.Position = p.positionFrom(t)
+			doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
 		case "enum":
-			def, err := p.ParseEnumTypeDefinition(description)
+			def, err := p.ParseEnumTypeExtension()
 			if err != nil {
-				return nil, err
+				return err
 			}
-			doc.TypeDefinitions = append(doc.TypeDefinitions, def)
+			def.Position = p.positionFrom(t)
+			doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
 		case "input":
-			def, err := p.ParseInputObjectTypeDefinition(description)
+			def, err := p.ParseInputObjectTypeExtension()
 			if err != nil {
-				return nil, err
+				return err
 			}
-			doc.TypeDefinitions = append(doc.TypeDefinitions, def)
+			def.Position = p.positionFrom(t)
+			doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
 		case "scalar":
-			def, err := p.ParseScalarTypeDefinition(description)
+			def, err := p.ParseScalarTypeExtension()
 			if err != nil {
-				return nil, err
+				return err
 			}
-			doc.TypeDefinitions = append(doc.TypeDefinitions, def)
-		case "directive":
-			directiveDefinition, err := p.ParseDirectiveDefinition(description)
-			if err != nil {
-				return nil, err
-			}
-			doc.DirectiveDefinitions = append(doc.DirectiveDefinitions, *directiveDefinition)
+			def.Position = p.positionFrom(t)
+			doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
 		case "schema":
-			schemaDef, err := p.ParseSchemaDefinition(description)
+			def, err := p.ParseSchemaExtension()
 			if err != nil {
-				return nil, err
-			}
-			doc.SchemaDefinitions = append(doc.SchemaDefinitions, *schemaDef)
-		case "extend":
-			if err = p.SkipKeyword("extend"); err != nil {
-				return nil, err
+				return err
 			}
+			def.Position = p.positionFrom(t)
+			doc.SchemaExtensions = append(doc.SchemaExtensions, *def)
+		default:
+			return p.errorAt(keyword, "expected type system extension after \"extend\"")
+		}
+	default:
+		return p.errorAt(t, "expected type system definition or extension")
+	}
+
+	return nil
+}
 
-			keyword, err := p.ReadNameValue()
+// ParseExecutableDocument parses src as a document of operations and fragments.
+// A type system definition or extension in src is a syntax error, as an executable document must contain only
+// executable definitions.
+func ParseExecutableDocument(src *ast.Source) (doc *ast.ExecutableDocument, err error) {
+	doc = &ast.ExecutableDocument{}
+	p := newParser(src)
+
+	for {
+		t := p.PeekToken()
+		if t.Kind == gogqllexer.EOF {
+			break
+		}
+
+		if t.Kind == gogqllexer.Name && t.Value == "fragment" {
+			def, err := p.ParseFragmentDefinition()
 			if err != nil {
 				return nil, err
 			}
-			switch keyword {
-			case "type":
-				def, err := p.ParseObjectTypeExtension()
-				if err != nil {
-					return nil, err
-				}
-				doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
-			case "interface":
-				def, err := p.ParseInterfaceTypeExtension()
-				if err != nil {
-					return nil, err
-				}
-				doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
-			case "union":
-				def, err := p.ParseUnionTypeExtension()
-				if err != nil {
-					return nil, err
-				}
-				doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
-			case "enum":
-				def, err := p.ParseEnumTypeExtension()
-				if err != nil {
-					return nil, err
-				}
-				doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
-			case "input":
-				def, err := p.ParseInputObjectTypeExtension()
-				if err != nil {
-					return nil, err
-				}
-				doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
-			case "scalar":
-				def, err := p.ParseScalarTypeExtension()
-				if err != nil {
-					return nil, err
-				}
-				doc.TypeSystemExtensions = append(doc.TypeSystemExtensions, def)
-			case "schema":
-				def, err := p.ParseSchemaExtension()
-				if err != nil {
-					return nil, err
-				}
-				doc.SchemaExtensions = append(doc.SchemaExtensions, *def)
-			default:
-				return nil, fmt.Errorf("unexpected token %+v", t.Value)
-			}
-		default:
-			return nil, fmt.Errorf("unexpected token %+v", t.Value)
+			doc.FragmentDefinitions = append(doc.FragmentDefinitions, def)
+			continue
+		}
+
+		if isTypeSystemDefinitionStart(t) {
+			return nil, p.errorAt(t, "executable document must not contain type system definition")
+		}
+		if t.Kind != gogqllexer.BraceL && t.Kind != gogqllexer.Name {
+			return nil, p.errorAt(t, "expected operation or fragment definition")
+		}
+
+		def, err := p.ParseOperationDefinition()
+		if err != nil {
+			return nil, err
 		}
+		doc.OperationDefinitions = append(doc.OperationDefinitions, def)
 	}
 
 	return doc, nil
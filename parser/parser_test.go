@@ -0,0 +1,192 @@
+package parser
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"testing"
+)
+
+// ignorePosition lets tests describe the expected tree without spelling out every position.
+var ignorePosition = cmpopts.IgnoreTypes(&ast.Position{})
+
+func TestParseTypeSystemExtensionDocument_Position(t *testing.T) {
+	src := &ast.Source{
+		Name: "schema.graphql",
+		Body: "type Query {\n\n\t\"description\"\n\tname(arg: Int @d): String!\n}\r\n# comment\nextend scalar Time @d\n",
+	}
+
+	doc, err := ParseTypeSystemExtensionDocument(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj := doc.TypeDefinitions[0].(*ast.ObjectTypeDefinition)
+	field := obj.FieldDefinitions[0]
+	arg := field.ArgumentDefinition[0]
+	ext := doc.TypeSystemExtensions[0].(*ast.ScalarTypeExtension)
+
+	tests := []struct {
+		name string
+		got  *ast.Position
+		want *ast.Position
+	}{
+		{
+			name: "object type definition",
+			got:  obj.Position,
+			want: &ast.Position{Source: src, Start: 0, End: 58, Line: 1, Column: 1},
+		},
+		{
+			name: "field definition starts after its description",
+			got:  field.Position,
+			want: &ast.Position{Source: src, Start: 30, End: 56, Line: 4, Column: 2},
+		},
+		{
+			name: "argument definition",
+			got:  arg.Position,
+			want: &ast.Position{Source: src, Start: 35, End: 46, Line: 4, Column: 7},
+		},
+		{
+			name: "directive",
+			got:  arg.Directives[0].Position,
+			want: &ast.Position{Source: src, Start: 44, End: 46, Line: 4, Column: 16},
+		},
+		{
+			name: "field type",
+			got:  field.Type.Position,
+			want: &ast.Position{Source: src, Start: 49, End: 56, Line: 4, Column: 21},
+		},
+		{
+			name: "extension starts at extend keyword",
+			got:  ext.Position,
+			want: &ast.Position{Source: src, Start: 70, End: 91, Line: 7, Column: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !cmp.Equal(tt.got, tt.want) {
+				t.Errorf("Position = %+v, want %+v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTypeSystemExtensionDocumentWithRecovery(t *testing.T) {
+	tests := []struct {
+		name          string
+		schema        string
+		wantTypeNames []string
+		wantErrLines  []int
+	}{
+		{
+			name: "valid document",
+			schema: `
+type Query {
+	user: User
+}
+
+type User {
+	id: ID!
+}
+`,
+			wantTypeNames: []string{"Query", "User"},
+		},
+		{
+			name: "resynchronize at next definition",
+			schema: `
+type Query {
+	user User
+}
+
+"""
+User description
+"""
+type User {
+	id: ID!
+	type: String
+}
+
+interface Node @key(fields: [id) {
+	id: ID!
+}
+
+extend schema
+
+enum Role {
+	ADMIN
+}
+
+typo Broken {}
+
+scalar Time
+`,
+			wantTypeNames: []string{"User", "Role", "Time"},
+			wantErrLines:  []int{3, 14, 20, 24},
+		},
+		{
+			name: "unclosed definition at end of file",
+			schema: `
+scalar Time
+
+type Query {
+	user: User
+`,
+			wantTypeNames: []string{"Time"},
+			wantErrLines:  []int{6},
+		},
+		{
+			name: "resynchronize after unclosed brace",
+			schema: `type A { b:
+type B { c: Int }
+type C { d: Int }
+`,
+			wantTypeNames: []string{"B", "C"},
+			wantErrLines:  []int{2},
+		},
+		{
+			name: "resynchronize after unterminated string",
+			schema: `type A { a(x: String = "oops): Int }
+type B { b: Int }
+type C { c Int }
+scalar D
+`,
+			wantTypeNames: []string{"B", "D"},
+			wantErrLines:  []int{1, 3},
+		},
+		{
+			name: "stop at invalid token",
+			schema: `
+scalar Time
+
+type Query {
+	user: ü
+}
+
+scalar Date
+`,
+			wantTypeNames: []string{"Time"},
+			wantErrLines:  []int{5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, errs := ParseTypeSystemExtensionDocumentWithRecovery(&ast.Source{Body: tt.schema})
+
+			var gotTypeNames []string
+			for _, def := range doc.TypeDefinitions {
+				gotTypeNames = append(gotTypeNames, def.TypeName())
+			}
+			if !cmp.Equal(gotTypeNames, tt.wantTypeNames) {
+				t.Errorf("ParseTypeSystemExtensionDocumentWithRecovery() type names = %v, want %v", gotTypeNames, tt.wantTypeNames)
+			}
+
+			var gotErrLines []int
+			for _, err := range errs {
+				gotErrLines = append(gotErrLines, err.Line)
+			}
+			if !cmp.Equal(gotErrLines, tt.wantErrLines) {
+				t.Errorf("ParseTypeSystemExtensionDocumentWithRecovery() errs = %v, want errors on lines %v", errs, tt.wantErrLines)
+			}
+		})
+	}
+}
@@ -1,12 +1,12 @@
 package parser
 
 import (
-	"fmt"
 	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
 )
 
 func (p *parser) ParseScalarTypeDefinition(description string) (def *ast.ScalarTypeDefinition, err error) {
+	start := p.PeekToken()
 	def = &ast.ScalarTypeDefinition{
 		Description: description,
 	}
@@ -20,11 +20,12 @@ func (p *parser) ParseScalarTypeDefinition(description string) (def *ast.ScalarT
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, nil
 }
 
@@ -33,6 +34,7 @@ func (p *parser) ParseScalarTypeDefinition(description string) (def *ast.ScalarT
 //
 // Reference: https://spec.graphql.org/October2021/#sec-Scalar-Extensions
 func (p *parser) ParseScalarTypeExtension() (def *ast.ScalarTypeExtension, err error) {
+	start := p.PeekToken()
 	def = &ast.ScalarTypeExtension{}
 
 	if err = p.SkipKeyword("scalar"); err != nil {
@@ -44,12 +46,13 @@ func (p *parser) ParseScalarTypeExtension() (def *ast.ScalarTypeExtension, err e
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	} else {
-		return nil, fmt.Errorf("scalar type extension needs at least one directive")
+		return nil, p.unexpected("expected '@' in scalar type extension")
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, nil
 }
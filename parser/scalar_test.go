@@ -1,10 +1,8 @@
 package parser
 
 import (
-	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
-	"reflect"
-	"strings"
+	"github.com/google/go-cmp/cmp"
 	"testing"
 )
 
@@ -28,7 +26,7 @@ scalar Int @max(n: 100)
 						Arguments: []ast.Argument{
 							{
 								Name:  "n",
-								Value: "100",
+								Value: ast.IntValue{Value: 100},
 							},
 						},
 					},
@@ -49,15 +47,13 @@ type Example {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &parser{
-				lexer: gogqllexer.New(strings.NewReader(tt.schema)),
-			}
+			p := newParser(&ast.Source{Body: tt.schema})
 			gotDef, err := p.ParseScalarTypeExtension()
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseScalarTypeExtension() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(gotDef, tt.wantDef) {
+			if !cmp.Equal(gotDef, tt.wantDef, ignorePosition) {
 				t.Errorf("ParseScalarTypeExtension() gotDef = %v, want %v", gotDef, tt.wantDef)
 			}
 		})
@@ -1,7 +1,6 @@
 package parser
 
 import (
-	"fmt"
 	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
 )
@@ -17,18 +16,14 @@ func (p *parser) ParseRootOperationTypeDefinitions() (defs map[string]*ast.RootO
 		var rootOperationName string
 		var rootOperationTypeName string
 
-		if rootOperationName, err = p.ReadNameValue(); err != nil {
-			return nil, err
-		}
-
-		switch rootOperationName {
-		default:
-			return nil, fmt.Errorf("unexpected root operation name %s", rootOperationName)
-		case "query", "mutation", "subscription":
+		start := p.NextToken()
+		rootOperationName = start.Value
+		if _, ok := parseOperationType(rootOperationName); start.Kind != gogqllexer.Name || !ok {
+			return nil, p.errorAt(start, "expected \"query\", \"mutation\" or \"subscription\"")
 		}
 
 		if err = p.Skip(gogqllexer.Colon); err != nil {
-			return nil, err
+			return nil, withContext(err, "after root operation name")
 		}
 
 		if rootOperationTypeName, err = p.ReadNameValue(); err != nil {
@@ -36,11 +31,12 @@ func (p *parser) ParseRootOperationTypeDefinitions() (defs map[string]*ast.RootO
 		}
 
 		if _, ok := defs[rootOperationName]; ok {
-			return nil, fmt.Errorf("duplicate root operation name %s", rootOperationName)
+			return nil, p.errorAt(start, "expected each root operation type to be defined once, %q is duplicated", rootOperationName)
 		}
 
 		defs[rootOperationName] = &ast.RootOperationTypeDefinition{
-			Type: rootOperationTypeName,
+			Type:     rootOperationTypeName,
+			Position: p.positionFrom(start),
 		}
 
 		if p.SkipIf(gogqllexer.BraceR) {
@@ -48,14 +44,11 @@ func (p *parser) ParseRootOperationTypeDefinitions() (defs map[string]*ast.RootO
 		}
 	}
 
-	if len(defs) == 0 {
-		return nil, fmt.Errorf("schema definition must have at least one root operation type definition")
-	}
-
 	return defs, err
 }
 
 func (p *parser) ParseSchemaDefinition(description string) (def *ast.SchemaDefinition, err error) {
+	start := p.PeekToken()
 	def = &ast.SchemaDefinition{
 		Description: description,
 	}
@@ -65,7 +58,7 @@ func (p *parser) ParseSchemaDefinition(description string) (def *ast.SchemaDefin
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	}
@@ -79,9 +72,10 @@ func (p *parser) ParseSchemaDefinition(description string) (def *ast.SchemaDefin
 		def.Mutation = rootOperationTypeDefs["mutation"]
 		def.Subscription = rootOperationTypeDefs["subscription"]
 	} else {
-		return nil, fmt.Errorf("schema definition must have at least one root operation type definition")
+		return nil, p.unexpected("expected '{' in schema definition")
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, err
 }
 
@@ -90,6 +84,7 @@ func (p *parser) ParseSchemaDefinition(description string) (def *ast.SchemaDefin
 //
 // Reference: https://spec.graphqp.org/October2021/#sec-Schema-Extension
 func (p *parser) ParseSchemaExtension() (def *ast.SchemaExtension, err error) {
+	start := p.PeekToken()
 	def = &ast.SchemaExtension{}
 
 	if err = p.SkipKeyword("schema"); err != nil {
@@ -97,7 +92,7 @@ func (p *parser) ParseSchemaExtension() (def *ast.SchemaExtension, err error) {
 	}
 	var canOmitRootOperationTypes bool
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 
@@ -113,8 +108,9 @@ func (p *parser) ParseSchemaExtension() (def *ast.SchemaExtension, err error) {
 		def.Mutation = rootOperationTypeDefs["mutation"]
 		def.Subscription = rootOperationTypeDefs["subscription"]
 	} else if !canOmitRootOperationTypes {
-		return nil, fmt.Errorf("schema extension must have at least one root operation type definition or directive")
+		return nil, p.unexpected("expected '@' or '{' in schema extension")
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, err
 }
@@ -0,0 +1,117 @@
+package parser
+
+import (
+	"github.com/Sntree2mi8/gogqllexer"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+// https://spec.graphql.org/October2021/#SelectionSet
+func (p *parser) parseSelectionSet() (set ast.SelectionSet, err error) {
+	if err = p.Skip(gogqllexer.BraceL); err != nil {
+		return nil, err
+	}
+
+	for {
+		var selection ast.Selection
+		if p.CheckKind(gogqllexer.Spread) {
+			selection, err = p.parseFragment()
+		} else {
+			selection, err = p.parseField()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		set = append(set, selection)
+
+		if p.SkipIf(gogqllexer.BraceR) {
+			break
+		}
+	}
+
+	return set, nil
+}
+
+// https://spec.graphql.org/October2021/#Field
+func (p *parser) parseField() (f *ast.Field, err error) {
+	start := p.PeekToken()
+	f = &ast.Field{}
+
+	if f.Name, err = p.ReadNameValue(); err != nil {
+		return nil, err
+	}
+
+	if p.SkipIf(gogqllexer.Colon) {
+		f.Alias = f.Name
+		if f.Name, err = p.ReadNameValue(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.CheckKind(gogqllexer.ParenL) {
+		if f.Arguments, err = p.parseArguments(false); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.CheckKind(gogqllexer.At) {
+		if f.Directives, err = p.parseDirectives(false); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.CheckKind(gogqllexer.BraceL) {
+		if f.SelectionSet, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+	}
+
+	f.Position = p.positionFrom(start)
+	return f, nil
+}
+
+// parseFragment parses a fragment spread or an inline fragment.
+//
+// Reference: https://spec.graphql.org/October2021/#FragmentSpread
+// Reference: https://spec.graphql.org/October2021/#InlineFragment
+func (p *parser) parseFragment() (s ast.Selection, err error) {
+	start := p.PeekToken()
+	if err = p.Skip(gogqllexer.Spread); err != nil {
+		return nil, err
+	}
+
+	if p.CheckKind(gogqllexer.Name) && !p.CheckKeyword("on") {
+		spread := &ast.FragmentSpread{}
+		spread.FragmentName, _ = p.ReadNameValue()
+
+		if p.CheckKind(gogqllexer.At) {
+			if spread.Directives, err = p.parseDirectives(false); err != nil {
+				return nil, err
+			}
+		}
+
+		spread.Position = p.positionFrom(start)
+		return spread, nil
+	}
+
+	inline := &ast.InlineFragment{}
+
+	if p.SkipKeywordIf("on") {
+		if inline.TypeCondition, err = p.ReadNameValue(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.CheckKind(gogqllexer.At) {
+		if inline.Directives, err = p.parseDirectives(false); err != nil {
+			return nil, err
+		}
+	}
+
+	if inline.SelectionSet, err = p.parseSelectionSet(); err != nil {
+		return nil, err
+	}
+
+	inline.Position = p.positionFrom(start)
+	return inline, nil
+}
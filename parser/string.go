@@ -0,0 +1,169 @@
+package parser
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqllexer"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// stringValue returns the value of a String or BlockString token,
+// whose Value still holds the surrounding quotes as written in the source.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-String-Value.Semantics
+func stringValue(t gogqllexer.Token) (string, error) {
+	if t.Kind == gogqllexer.BlockString {
+		raw := strings.TrimSuffix(strings.TrimPrefix(t.Value, `"""`), `"""`)
+		return blockStringValue(strings.ReplaceAll(raw, `\"""`, `"""`)), nil
+	}
+
+	raw := strings.TrimSuffix(strings.TrimPrefix(t.Value, `"`), `"`)
+	return unescapeString(raw)
+}
+
+func unescapeString(raw string) (string, error) {
+	if !strings.Contains(raw, `\`) {
+		return raw, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '\\' {
+			b.WriteByte(raw[i])
+			continue
+		}
+
+		i++
+		if i >= len(raw) {
+			return "", fmt.Errorf("expected escape sequence after '\\'")
+		}
+		switch raw[i] {
+		case '"', '\\', '/':
+			b.WriteByte(raw[i])
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'u':
+			r, n, err := readEscapedUnicode(raw[i+1:])
+			if err != nil {
+				return "", err
+			}
+			i += n
+
+			// a leading surrogate must be followed by an escaped trailing surrogate.
+			if utf16.IsSurrogate(r) {
+				if !strings.HasPrefix(raw[i+1:], `\u`) {
+					return "", fmt.Errorf("expected trailing surrogate after \\u%04X", r)
+				}
+				trailing, m, err := readEscapedUnicode(raw[i+3:])
+				if err != nil {
+					return "", err
+				}
+				if r = utf16.DecodeRune(r, trailing); r == utf8.RuneError {
+					return "", fmt.Errorf("expected valid surrogate pair")
+				}
+				i += 2 + m
+			}
+			b.WriteRune(r)
+		default:
+			return "", fmt.Errorf("expected valid escape sequence, found \\%c", raw[i])
+		}
+	}
+
+	return b.String(), nil
+}
+
+// readEscapedUnicode reads the digits of \uXXXX or \u{X...} and reports how many bytes were consumed.
+func readEscapedUnicode(s string) (r rune, n int, err error) {
+	digits := s
+	if strings.HasPrefix(s, "{") {
+		end := strings.IndexByte(s, '}')
+		if end < 0 {
+			return 0, 0, fmt.Errorf("expected '}' in unicode escape sequence")
+		}
+		digits, n = s[1:end], end+1
+	} else {
+		if len(s) < 4 {
+			return 0, 0, fmt.Errorf("expected 4 hex digits in unicode escape sequence")
+		}
+		digits, n = s[:4], 4
+	}
+
+	v, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil || v > utf8.MaxRune {
+		return 0, 0, fmt.Errorf("expected valid unicode escape sequence, found \\u%s", s[:n])
+	}
+	return rune(v), n, nil
+}
+
+// blockStringValue removes the common indentation and the leading and trailing blank lines of a block string.
+//
+// Reference: https://spec.graphql.org/October2021/#BlockStringValue()
+func blockStringValue(raw string) string {
+	lines := splitLines(raw)
+
+	commonIndent := -1
+	for _, line := range lines[1:] {
+		indent := leadingWhitespace(line)
+		if indent == len(line) {
+			continue
+		}
+		if commonIndent < 0 || indent < commonIndent {
+			commonIndent = indent
+		}
+	}
+	if commonIndent > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) >= commonIndent {
+				lines[i] = lines[i][commonIndent:]
+			} else {
+				lines[i] = ""
+			}
+		}
+	}
+
+	for len(lines) > 0 && leadingWhitespace(lines[0]) == len(lines[0]) {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && leadingWhitespace(lines[len(lines)-1]) == len(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func splitLines(s string) []string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	return strings.Split(s, "\n")
+}
+
+func leadingWhitespace(s string) int {
+	i := 0
+	for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+		i++
+	}
+	return i
+}
+
+// StringValue returns the value of a string literal written in GraphQL syntax, such as a description.
+// Both "string" and """block string""" literals are accepted.
+func StringValue(raw string) (string, error) {
+	switch {
+	case len(raw) >= 6 && strings.HasPrefix(raw, `"""`) && strings.HasSuffix(raw, `"""`):
+		return stringValue(gogqllexer.Token{Kind: gogqllexer.BlockString, Value: raw})
+	case len(raw) >= 2 && strings.HasPrefix(raw, `"`) && strings.HasSuffix(raw, `"`):
+		return stringValue(gogqllexer.Token{Kind: gogqllexer.String, Value: raw})
+	default:
+		return "", fmt.Errorf("expected string literal, found %s", raw)
+	}
+}
@@ -6,6 +6,7 @@ import (
 )
 
 func (p *parser) parseType() (t ast.Type, err error) {
+	start := p.PeekToken()
 	if p.SkipIf(gogqllexer.BracketL) {
 		listType, err := p.parseType()
 		if err != nil {
@@ -26,5 +27,6 @@ func (p *parser) parseType() (t ast.Type, err error) {
 		t.NotNull = true
 	}
 
+	t.Position = p.positionFrom(start)
 	return t, nil
 }
@@ -3,7 +3,6 @@ package parser
 import (
 	"github.com/Sntree2mi8/gogqllexer"
 	"github.com/Sntree2mi8/gogqlparser/ast"
-	"log"
 )
 
 // https://spec.graphql.org/October2021/#UnionMemberTypes
@@ -16,11 +15,12 @@ func (p *parser) parseUnionMemberTypes() (memberTypes []ast.Type, err error) {
 
 	for {
 		var mt ast.Type
+		start := p.PeekToken()
 		if mt.NamedType, err = p.ReadNameValue(); err != nil {
-			log.Println("here")
 			return nil, err
 		}
 
+		mt.Position = p.positionFrom(start)
 		memberTypes = append(memberTypes, mt)
 
 		if !p.SkipIf(gogqllexer.Pipe) {
@@ -33,6 +33,7 @@ func (p *parser) parseUnionMemberTypes() (memberTypes []ast.Type, err error) {
 
 // https://spec.graphql.org/October2021/#sec-Unions
 func (p *parser) ParseUnionTypeDefinition(description string) (def *ast.UnionTypeDefinition, err error) {
+	start := p.PeekToken()
 	def = &ast.UnionTypeDefinition{
 		Description: description,
 	}
@@ -46,7 +47,7 @@ func (p *parser) ParseUnionTypeDefinition(description string) (def *ast.UnionTyp
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	}
@@ -63,6 +64,7 @@ func (p *parser) ParseUnionTypeDefinition(description string) (def *ast.UnionTyp
 		return nil, err
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, nil
 }
 
@@ -71,6 +73,7 @@ func (p *parser) ParseUnionTypeDefinition(description string) (def *ast.UnionTyp
 //
 // Reference: https://spec.graphql.org/October2021/#sec-Union-Extensions
 func (p *parser) ParseUnionTypeExtension() (def *ast.UnionTypeExtension, err error) {
+	start := p.PeekToken()
 	def = &ast.UnionTypeExtension{}
 
 	if err = p.SkipKeyword("union"); err != nil {
@@ -82,7 +85,7 @@ func (p *parser) ParseUnionTypeExtension() (def *ast.UnionTypeExtension, err err
 	}
 
 	if p.CheckKind(gogqllexer.At) {
-		if def.Directives, err = p.parseDirectives(); err != nil {
+		if def.Directives, err = p.parseDirectives(true); err != nil {
 			return nil, err
 		}
 	}
@@ -93,5 +96,6 @@ func (p *parser) ParseUnionTypeExtension() (def *ast.UnionTypeExtension, err err
 		}
 	}
 
+	def.Position = p.positionFrom(start)
 	return def, err
 }
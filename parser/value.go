@@ -0,0 +1,165 @@
+package parser
+
+import (
+	"github.com/Sntree2mi8/gogqllexer"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"strconv"
+)
+
+// parseValue parses a value literal.
+// variables are only allowed in executable documents, so isConst must be true in type system documents.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Input-Values
+func (p *parser) parseValue(isConst bool) (v ast.Value, err error) {
+	t := p.PeekToken()
+
+	switch t.Kind {
+	case gogqllexer.Dollar:
+		if isConst {
+			return nil, p.unexpected("expected constant value")
+		}
+		if v, err = p.parseVariable(); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case gogqllexer.BracketL:
+		if v, err = p.parseListValue(isConst); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case gogqllexer.BraceL:
+		if v, err = p.parseObjectValue(isConst); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case gogqllexer.Int:
+		p.NextToken()
+		i, err := strconv.ParseInt(t.Value, 10, 64)
+		if err != nil {
+			return nil, p.errorAt(t, "expected Int within 64-bit range")
+		}
+		return ast.IntValue{Value: i, Position: p.positionFrom(t)}, nil
+	case gogqllexer.Float:
+		p.NextToken()
+		f, err := strconv.ParseFloat(t.Value, 64)
+		if err != nil {
+			return nil, p.errorAt(t, "expected Float within 64-bit range")
+		}
+		return ast.FloatValue{Value: f, Position: p.positionFrom(t)}, nil
+	case gogqllexer.String, gogqllexer.BlockString:
+		p.NextToken()
+		s, err := stringValue(t)
+		if err != nil {
+			return nil, p.errorAt(t, "%s", err)
+		}
+		return ast.StringValue{Value: s, Position: p.positionFrom(t)}, nil
+	case gogqllexer.Name:
+		p.NextToken()
+		switch t.Value {
+		case "true", "false":
+			return ast.BooleanValue{Value: t.Value == "true", Position: p.positionFrom(t)}, nil
+		case "null":
+			return ast.NullValue{Position: p.positionFrom(t)}, nil
+		default:
+			return ast.EnumValue{Value: t.Value, Position: p.positionFrom(t)}, nil
+		}
+	default:
+		return nil, p.unexpected("expected value")
+	}
+}
+
+// ParseConstValue parses src which holds only a constant value, e.g. the defaultValue of an introspection result.
+//
+// Reference: https://spec.graphql.org/October2021/#Value
+func ParseConstValue(src *ast.Source) (ast.Value, error) {
+	p := newParser(src)
+
+	v, err := p.parseValue(true)
+	if err != nil {
+		return nil, err
+	}
+	if !p.CheckKind(gogqllexer.EOF) {
+		return nil, p.unexpected("expected end of value")
+	}
+
+	return v, nil
+}
+
+// parseDefaultValue parses a constant value and also returns it as written in the source.
+// "=" must be consumed before calling this function.
+//
+// Reference: https://spec.graphql.org/October2021/#DefaultValue
+func (p *parser) parseDefaultValue() (v ast.Value, raw string, err error) {
+	start := p.PeekToken()
+
+	if v, err = p.parseValue(true); err != nil {
+		return nil, "", err
+	}
+
+	return v, p.src.Body[tokenStart(start):p.lastEnd], nil
+}
+
+// https://spec.graphql.org/October2021/#Variable
+func (p *parser) parseVariable() (v ast.Variable, err error) {
+	start := p.PeekToken()
+
+	if err = p.Skip(gogqllexer.Dollar); err != nil {
+		return v, err
+	}
+	if v.Name, err = p.ReadNameValue(); err != nil {
+		return v, withContext(err, "after '$'")
+	}
+
+	v.Position = p.positionFrom(start)
+	return v, nil
+}
+
+// https://spec.graphql.org/October2021/#ListValue
+func (p *parser) parseListValue(isConst bool) (v ast.ListValue, err error) {
+	start := p.PeekToken()
+
+	if err = p.Skip(gogqllexer.BracketL); err != nil {
+		return v, err
+	}
+
+	for !p.SkipIf(gogqllexer.BracketR) {
+		var item ast.Value
+		if item, err = p.parseValue(isConst); err != nil {
+			return v, err
+		}
+		v.Values = append(v.Values, item)
+	}
+
+	v.Position = p.positionFrom(start)
+	return v, nil
+}
+
+// https://spec.graphql.org/October2021/#ObjectValue
+func (p *parser) parseObjectValue(isConst bool) (v ast.ObjectValue, err error) {
+	start := p.PeekToken()
+
+	if err = p.Skip(gogqllexer.BraceL); err != nil {
+		return v, err
+	}
+
+	for !p.SkipIf(gogqllexer.BraceR) {
+		var field ast.ObjectField
+
+		fieldStart := p.PeekToken()
+		if field.Name, err = p.ReadNameValue(); err != nil {
+			return v, withContext(err, "for object field name")
+		}
+		if err = p.Skip(gogqllexer.Colon); err != nil {
+			return v, withContext(err, "after object field name")
+		}
+		if field.Value, err = p.parseValue(isConst); err != nil {
+			return v, err
+		}
+
+		field.Position = p.positionFrom(fieldStart)
+		v.Fields = append(v.Fields, field)
+	}
+
+	v.Position = p.positionFrom(start)
+	return v, nil
+}
@@ -0,0 +1,260 @@
+package parser
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func TestParseValue(t *testing.T) {
+	type args struct {
+		isConst bool
+	}
+	tests := []struct {
+		name    string
+		value   string
+		args    args
+		want    ast.Value
+		wantErr bool
+	}{
+		{
+			name:  "int",
+			value: `-42`,
+			args:  args{isConst: true},
+			want:  ast.IntValue{Value: -42},
+		},
+		{
+			name:  "float",
+			value: `1.5e3`,
+			args:  args{isConst: true},
+			want:  ast.FloatValue{Value: 1500},
+		},
+		{
+			name:  "string with escape sequences",
+			value: `"tab\t quote\" unicode\u0041 surrogate\uD83D\uDE00 raw😀"`,
+			args:  args{isConst: true},
+			want:  ast.StringValue{Value: "tab\t quote\" unicodeA surrogate\U0001F600 raw\U0001F600"},
+		},
+		{
+			name: "block string",
+			value: `"""
+    first line
+      indented line
+
+    last line
+  """`,
+			args: args{isConst: true},
+			want: ast.StringValue{Value: "first line\n  indented line\n\nlast line"},
+		},
+		{
+			name:  "boolean",
+			value: `false`,
+			args:  args{isConst: true},
+			want:  ast.BooleanValue{Value: false},
+		},
+		{
+			name:  "null",
+			value: `null`,
+			args:  args{isConst: true},
+			want:  ast.NullValue{},
+		},
+		{
+			name:  "enum",
+			value: `ADMIN`,
+			args:  args{isConst: true},
+			want:  ast.EnumValue{Value: "ADMIN"},
+		},
+		{
+			name:  "empty list",
+			value: `[]`,
+			args:  args{isConst: true},
+			want:  ast.ListValue{},
+		},
+		{
+			name:  "nested list",
+			value: `["id", [1, 2]]`,
+			args:  args{isConst: true},
+			want: ast.ListValue{
+				Values: []ast.Value{
+					ast.StringValue{Value: "id"},
+					ast.ListValue{
+						Values: []ast.Value{
+							ast.IntValue{Value: 1},
+							ast.IntValue{Value: 2},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "object",
+			value: `{role: ADMIN, scopes: ["read"], nested: {limit: 10}}`,
+			args:  args{isConst: true},
+			want: ast.ObjectValue{
+				Fields: []ast.ObjectField{
+					{
+						Name:  "role",
+						Value: ast.EnumValue{Value: "ADMIN"},
+					},
+					{
+						Name: "scopes",
+						Value: ast.ListValue{
+							Values: []ast.Value{
+								ast.StringValue{Value: "read"},
+							},
+						},
+					},
+					{
+						Name: "nested",
+						Value: ast.ObjectValue{
+							Fields: []ast.ObjectField{
+								{
+									Name:  "limit",
+									Value: ast.IntValue{Value: 10},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "variable",
+			value: `{id: $id}`,
+			args:  args{isConst: false},
+			want: ast.ObjectValue{
+				Fields: []ast.ObjectField{
+					{
+						Name:  "id",
+						Value: ast.Variable{Name: "id"},
+					},
+				},
+			},
+		},
+		{
+			name:    "variable in constant value",
+			value:   `[$id]`,
+			args:    args{isConst: true},
+			wantErr: true,
+		},
+		{
+			name:    "unterminated list",
+			value:   `[1, 2`,
+			args:    args{isConst: true},
+			wantErr: true,
+		},
+		{
+			name:    "object field without colon",
+			value:   `{limit 10}`,
+			args:    args{isConst: true},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newParser(&ast.Source{Body: tt.value})
+			got, err := p.parseValue(tt.args.isConst)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseValue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !cmp.Equal(got, tt.want, ignorePosition) {
+				t.Errorf("parseValue() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "string",
+			raw:  `"line\nbreak"`,
+			want: "line\nbreak",
+		},
+		{
+			name: "block string",
+			raw:  "\"\"\"\n  first\n    second\n\"\"\"",
+			want: "first\n  second",
+		},
+		{
+			name:    "not quoted",
+			raw:     `description`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated",
+			raw:     `"description`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringValue(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringValue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("StringValue() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConstValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		want    ast.Value
+		wantErr bool
+	}{
+		{
+			name: "list of objects",
+			src:  `[{a: 1, b: "x"}, null]`,
+			want: ast.ListValue{Values: []ast.Value{
+				ast.ObjectValue{Fields: []ast.ObjectField{
+					{Name: "a", Value: ast.IntValue{Value: 1}},
+					{Name: "b", Value: ast.StringValue{Value: "x"}},
+				}},
+				ast.NullValue{},
+			}},
+		},
+		{
+			name: "enum",
+			src:  `ADMIN`,
+			want: ast.EnumValue{Value: "ADMIN"},
+		},
+		{
+			name:    "variable",
+			src:     `$a`,
+			wantErr: true,
+		},
+		{
+			name:    "trailing tokens",
+			src:     `1 2`,
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			src:     ``,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseConstValue(&ast.Source{Name: "value", Body: tt.src})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseConstValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, ignorePosition); diff != "" {
+				t.Errorf("ParseConstValue() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
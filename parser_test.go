@@ -1,7 +1,10 @@
 package gogqlparser
 
 import (
+	"errors"
+	"fmt"
 	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/parser"
 	"github.com/Sntree2mi8/gogqlparser/validator"
 	"testing"
 )
@@ -41,3 +44,62 @@ func TestParser_ParseTypeSystem(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestParser_ParseTypeSystem_Merge(t *testing.T) {
+	sources := make([]*ast.Source, 50)
+	for i := range sources {
+		sources[i] = &ast.Source{
+			Name: fmt.Sprintf("type%d.graphql", i),
+			Body: fmt.Sprintf("type Type%d { id: ID! }\nextend type Query { type%d: Type%d }\n", i, i, i),
+		}
+	}
+
+	d, err := New().ParseTypeSystem(sources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(d.TypeDefinitions) != len(sources) || len(d.TypeSystemExtensions) != len(sources) {
+		t.Fatalf("ParseTypeSystem() got %d type definitions and %d extensions, want %d", len(d.TypeDefinitions), len(d.TypeSystemExtensions), len(sources))
+	}
+	for i, def := range d.TypeDefinitions {
+		if want := fmt.Sprintf("Type%d", i); def.TypeName() != want {
+			t.Errorf("TypeDefinitions[%d] = %s, want %s", i, def.TypeName(), want)
+		}
+		if got := def.GetPosition().Source; got != sources[i] {
+			t.Errorf("TypeDefinitions[%d] comes from %s, want %s", i, got.Name, sources[i].Name)
+		}
+	}
+}
+
+func TestParser_ParseTypeSystem_Errors(t *testing.T) {
+	sources := []*ast.Source{
+		{Name: "a.graphql", Body: "type A {"},
+		{Name: "b.graphql", Body: "type B { id: ID }"},
+		{Name: "c.graphql", Body: "type C { id ID }"},
+	}
+
+	d, err := New().ParseTypeSystem(sources)
+	if d != nil {
+		t.Errorf("ParseTypeSystem() document = %v, want nil", d)
+	}
+
+	var sourceErrs SourceErrors
+	if !errors.As(err, &sourceErrs) {
+		t.Fatalf("ParseTypeSystem() error = %v, want SourceErrors", err)
+	}
+	if len(sourceErrs) != 2 || sourceErrs[0].Source != sources[0] || sourceErrs[1].Source != sources[2] {
+		t.Fatalf("ParseTypeSystem() error = %v, want errors of a.graphql and c.graphql", err)
+	}
+
+	var syntaxErr *parser.SyntaxError
+	if !errors.As(err, &syntaxErr) || syntaxErr.Source != sources[0] {
+		t.Errorf("ParseTypeSystem() error = %v, want SyntaxError of a.graphql", err)
+	}
+
+	want := "a.graphql:1:9: syntax error: expected Name, found <EOF>\n" +
+		"c.graphql:1:13: syntax error: expected ':' after field name, found Name \"ID\""
+	if err.Error() != want {
+		t.Errorf("Error() = %s, want %s", err.Error(), want)
+	}
+}
@@ -0,0 +1,329 @@
+package printer
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+func (p *printer) printDefinition(node any) {
+	switch def := node.(type) {
+	case *ast.SchemaDefinition:
+		p.printSchemaDefinition(def)
+	case *ast.SchemaExtension:
+		p.printSchemaExtension(def)
+	case *ast.DirectiveDefinition:
+		p.printDirectiveDefinition(def)
+
+	case *ast.ScalarTypeDefinition:
+		p.printDescription(def.Description)
+		p.WriteString("scalar " + def.Name)
+		p.printDirectives(def.Directives)
+		p.newline()
+	case *ast.ObjectTypeDefinition:
+		p.printDescription(def.Description)
+		p.WriteString("type " + def.Name)
+		p.printImplements(def.Interfaces)
+		p.printDirectives(def.Directives)
+		p.printFieldDefinitions(def.FieldDefinitions)
+		p.newline()
+	case *ast.InterfaceTypeDefinition:
+		p.printDescription(def.Description)
+		p.WriteString("interface " + def.Name)
+		p.printImplements(def.Interfaces)
+		p.printDirectives(def.Directives)
+		p.printFieldDefinitions(def.FieldDefinitions)
+		p.newline()
+	case *ast.UnionTypeDefinition:
+		p.printDescription(def.Description)
+		p.WriteString("union " + def.Name)
+		p.printDirectives(def.Directives)
+		p.printUnionMemberTypes(def.MemberTypes)
+		p.newline()
+	case *ast.EnumTypeDefinition:
+		p.printDescription(def.Description)
+		p.WriteString("enum " + def.Name)
+		p.printDirectives(def.Directives)
+		p.printEnumValueDefinitions(def.EnumValue)
+		p.newline()
+	case *ast.InputObjectTypeDefinition:
+		p.printDescription(def.Description)
+		p.WriteString("input " + def.Name)
+		p.printDirectives(def.Directives)
+		p.printInputFieldDefinitions(def.InputFields)
+		p.newline()
+
+	case *ast.ScalarTypeExtension:
+		p.WriteString("extend scalar " + def.Name)
+		p.printDirectives(def.Directives)
+		p.newline()
+	case *ast.ObjectTypeExtension:
+		p.WriteString("extend type " + def.Name)
+		p.printImplements(def.ImplementInterfaces)
+		p.printDirectives(def.Directives)
+		p.printFieldDefinitions(def.FieldsDefinition)
+		p.newline()
+	case *ast.InterfaceTypeExtension:
+		p.WriteString("extend interface " + def.Name)
+		p.printImplements(def.ImplementInterfaces)
+		p.printDirectives(def.Directives)
+		p.printFieldDefinitions(def.FieldsDefinition)
+		p.newline()
+	case *ast.UnionTypeExtension:
+		p.WriteString("extend union " + def.Name)
+		p.printDirectives(def.Directives)
+		p.printUnionMemberTypes(def.MemberTypes)
+		p.newline()
+	case *ast.EnumTypeExtension:
+		p.WriteString("extend enum " + def.Name)
+		p.printDirectives(def.Directives)
+		p.printEnumValueDefinitions(def.EnumValue)
+		p.newline()
+	case *ast.InputObjectTypeExtension:
+		p.WriteString("extend input " + def.Name)
+		p.printDirectives(def.Directives)
+		p.printInputFieldDefinitions(def.InputsFieldDefinition)
+		p.newline()
+
+	default:
+		p.setError(fmt.Errorf("printer: unsupported definition %T", node))
+	}
+}
+
+// https://spec.graphql.org/October2021/#SchemaDefinition
+func (p *printer) printSchemaDefinition(def *ast.SchemaDefinition) {
+	p.printDescription(def.Description)
+	p.WriteString("schema")
+	p.printDirectives(def.Directives)
+	p.printRootOperationTypeDefinitions(def.Query, def.Mutation, def.Subscription)
+	p.newline()
+}
+
+// https://spec.graphql.org/October2021/#SchemaExtension
+func (p *printer) printSchemaExtension(ext *ast.SchemaExtension) {
+	p.WriteString("extend schema")
+	p.printDirectives(ext.Directives)
+	p.printRootOperationTypeDefinitions(ext.Query, ext.Mutation, ext.Subscription)
+	p.newline()
+}
+
+func (p *printer) printRootOperationTypeDefinitions(query, mutation, subscription *ast.RootOperationTypeDefinition) {
+	if query == nil && mutation == nil && subscription == nil {
+		return
+	}
+
+	p.WriteString(" {\n")
+	p.depth++
+	for _, op := range []struct {
+		name string
+		def  *ast.RootOperationTypeDefinition
+	}{
+		{name: "query", def: query},
+		{name: "mutation", def: mutation},
+		{name: "subscription", def: subscription},
+	} {
+		if op.def == nil {
+			continue
+		}
+		p.writeIndent()
+		p.WriteString(op.name + ": " + op.def.Type)
+		p.newline()
+	}
+	p.depth--
+	p.WriteString("}")
+}
+
+// https://spec.graphql.org/October2021/#DirectiveDefinition
+func (p *printer) printDirectiveDefinition(def *ast.DirectiveDefinition) {
+	p.printDescription(def.Description)
+	p.WriteString("directive @" + def.Name)
+	p.printArgumentsDefinition(def.ArgumentsDefinition)
+	if def.IsRepeatable {
+		p.WriteString(" repeatable")
+	}
+	p.WriteString(" on ")
+	for i, loc := range def.DirectiveLocations {
+		if i > 0 {
+			p.WriteString(" | ")
+		}
+		p.WriteString(loc.String())
+	}
+	p.newline()
+}
+
+// https://spec.graphql.org/October2021/#ImplementsInterfaces
+func (p *printer) printImplements(interfaces []string) {
+	for i, name := range interfaces {
+		if i == 0 {
+			p.WriteString(" implements ")
+		} else {
+			p.WriteString(" & ")
+		}
+		p.WriteString(name)
+	}
+}
+
+// https://spec.graphql.org/October2021/#UnionMemberTypes
+func (p *printer) printUnionMemberTypes(types []ast.Type) {
+	for i, t := range types {
+		if i == 0 {
+			p.WriteString(" = ")
+		} else {
+			p.WriteString(" | ")
+		}
+		p.printType(t)
+	}
+}
+
+// https://spec.graphql.org/October2021/#FieldsDefinition
+func (p *printer) printFieldDefinitions(fields []*ast.FieldDefinition) {
+	if len(fields) == 0 {
+		return
+	}
+
+	p.WriteString(" {\n")
+	p.depth++
+	for _, f := range fields {
+		p.printDescription(f.Description)
+		p.writeIndent()
+		p.WriteString(f.Name)
+		p.printArgumentsDefinition(f.ArgumentDefinition)
+		p.WriteString(": ")
+		p.printType(f.Type)
+		p.printDirectives(f.Directives)
+		p.newline()
+	}
+	p.depth--
+	p.WriteString("}")
+}
+
+// printArgumentsDefinition prints arguments on one line unless one of them has a description.
+//
+// https://spec.graphql.org/October2021/#ArgumentsDefinition
+func (p *printer) printArgumentsDefinition(args []ast.InputValueDefinition) {
+	if len(args) == 0 {
+		return
+	}
+
+	multiline := false
+	for _, arg := range args {
+		if arg.Description != "" {
+			multiline = true
+			break
+		}
+	}
+
+	if !multiline {
+		p.WriteString("(")
+		for i := range args {
+			if i > 0 {
+				p.WriteString(", ")
+			}
+			p.printInputValueDefinition(&args[i])
+		}
+		p.WriteString(")")
+		return
+	}
+
+	p.WriteString("(\n")
+	p.depth++
+	for i := range args {
+		p.printDescription(args[i].Description)
+		p.writeIndent()
+		p.printInputValueDefinition(&args[i])
+		p.newline()
+	}
+	p.depth--
+	p.writeIndent()
+	p.WriteString(")")
+}
+
+// https://spec.graphql.org/October2021/#InputFieldsDefinition
+func (p *printer) printInputFieldDefinitions(fields []ast.InputValueDefinition) {
+	if len(fields) == 0 {
+		return
+	}
+
+	p.WriteString(" {\n")
+	p.depth++
+	for i := range fields {
+		p.printDescription(fields[i].Description)
+		p.writeIndent()
+		p.printInputValueDefinition(&fields[i])
+		p.newline()
+	}
+	p.depth--
+	p.WriteString("}")
+}
+
+// printInputValueDefinition prints def without its description.
+//
+// https://spec.graphql.org/October2021/#InputValueDefinition
+func (p *printer) printInputValueDefinition(def *ast.InputValueDefinition) {
+	p.WriteString(def.Name + ": ")
+	p.printType(def.Type)
+	if def.DefaultValue != nil {
+		p.WriteString(" = ")
+		p.printValue(def.DefaultValue)
+	} else if def.RawDefaultValue != "" {
+		p.WriteString(" = " + def.RawDefaultValue)
+	}
+	p.printDirectives(def.Directives)
+}
+
+// https://spec.graphql.org/October2021/#EnumValuesDefinition
+func (p *printer) printEnumValueDefinitions(values []ast.EnumValueDefinition) {
+	if len(values) == 0 {
+		return
+	}
+
+	p.WriteString(" {\n")
+	p.depth++
+	for _, v := range values {
+		p.printDescription(v.Description)
+		p.writeIndent()
+		p.WriteString(v.Value.Value)
+		p.printDirectives(v.Directives)
+		p.newline()
+	}
+	p.depth--
+	p.WriteString("}")
+}
+
+// https://spec.graphql.org/October2021/#Directives
+func (p *printer) printDirectives(directives []ast.Directive) {
+	for _, d := range directives {
+		p.WriteString(" @" + d.Name)
+		p.printArguments(d.Arguments)
+	}
+}
+
+// https://spec.graphql.org/October2021/#Arguments
+func (p *printer) printArguments(args []ast.Argument) {
+	if len(args) == 0 {
+		return
+	}
+
+	p.WriteString("(")
+	for i, arg := range args {
+		if i > 0 {
+			p.WriteString(", ")
+		}
+		p.WriteString(arg.Name + ": ")
+		p.printValue(arg.Value)
+	}
+	p.WriteString(")")
+}
+
+// https://spec.graphql.org/October2021/#Type
+func (p *printer) printType(t ast.Type) {
+	if t.ListType != nil {
+		p.WriteString("[")
+		p.printType(*t.ListType)
+		p.WriteString("]")
+	} else {
+		p.WriteString(t.NamedType)
+	}
+	if t.NotNull {
+		p.WriteString("!")
+	}
+}
@@ -0,0 +1,181 @@
+// Package printer renders type system documents as GraphQL SDL.
+package printer
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"io"
+	"sort"
+	"strings"
+)
+
+// DescriptionStyle decides how descriptions are quoted.
+type DescriptionStyle int
+
+const (
+	// DescriptionStyleAuto prints multi-line descriptions as block strings and the others as single-line strings.
+	DescriptionStyleAuto DescriptionStyle = iota
+	// DescriptionStyleBlock prints every description as a block string unless the string would read back differently.
+	DescriptionStyleBlock
+	// DescriptionStyleSingleLine prints every description as a single-line string.
+	DescriptionStyleSingleLine
+)
+
+// Order decides the order of top level definitions.
+type Order int
+
+const (
+	// OrderSource keeps definitions in the order they appear in their sources.
+	// Definitions without a position follow the others in document order.
+	OrderSource Order = iota
+	// OrderSorted prints schema definitions, schema extensions and directive definitions first,
+	// then type definitions sorted by name, each followed by its extensions.
+	OrderSorted
+)
+
+// Config controls the output of Fprint.
+// The zero value prints with two space indentation, DescriptionStyleAuto and OrderSource.
+type Config struct {
+	// Indent is written once per nesting level. Empty means two spaces.
+	Indent           string
+	DescriptionStyle DescriptionStyle
+	Order            Order
+}
+
+// Fprint writes doc to w as SDL using the default Config.
+func Fprint(w io.Writer, doc *ast.TypeSystemExtensionDocument) error {
+	return (&Config{}).Fprint(w, doc)
+}
+
+// Fprint writes doc to w as SDL.
+// Nothing is written if doc holds a description that is not a valid string literal.
+func (c *Config) Fprint(w io.Writer, doc *ast.TypeSystemExtensionDocument) error {
+	p := &printer{Config: *c}
+	if p.Indent == "" {
+		p.Indent = "  "
+	}
+
+	for i, def := range p.definitions(doc) {
+		if i > 0 {
+			p.newline()
+		}
+		p.printDefinition(def.node)
+	}
+	if p.err != nil {
+		return p.err
+	}
+
+	_, err := io.WriteString(w, p.String())
+	return err
+}
+
+type printer struct {
+	Config
+	strings.Builder
+
+	depth int
+	// err is the first error found while printing. printing goes on so that callers need not check every write.
+	err error
+}
+
+func (p *printer) setError(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+func (p *printer) writeIndent() {
+	for i := 0; i < p.depth; i++ {
+		p.WriteString(p.Indent)
+	}
+}
+
+func (p *printer) newline() {
+	p.WriteByte('\n')
+}
+
+// definition is a top level definition of a document with what is needed to order it.
+type definition struct {
+	node        any
+	rank        int
+	name        string
+	isExtension bool
+	position    *ast.Position
+
+	// source and offset are set by sortBySource.
+	source int
+	offset int
+}
+
+const (
+	rankSchema = iota
+	rankSchemaExtension
+	rankDirective
+	rankType
+)
+
+func (p *printer) definitions(doc *ast.TypeSystemExtensionDocument) []definition {
+	var defs []definition
+	for i := range doc.SchemaDefinitions {
+		def := &doc.SchemaDefinitions[i]
+		defs = append(defs, definition{node: def, rank: rankSchema, position: def.Position})
+	}
+	for i := range doc.SchemaExtensions {
+		ext := &doc.SchemaExtensions[i]
+		defs = append(defs, definition{node: ext, rank: rankSchemaExtension, isExtension: true, position: ext.Position})
+	}
+	for i := range doc.DirectiveDefinitions {
+		def := &doc.DirectiveDefinitions[i]
+		defs = append(defs, definition{node: def, rank: rankDirective, name: def.Name, position: def.Position})
+	}
+	for _, def := range doc.TypeDefinitions {
+		defs = append(defs, definition{node: def, rank: rankType, name: def.TypeName(), position: def.GetPosition()})
+	}
+	for _, ext := range doc.TypeSystemExtensions {
+		defs = append(defs, definition{node: ext, rank: rankType, name: ext.TypeName(), isExtension: true, position: ext.GetPosition()})
+	}
+
+	switch p.Order {
+	case OrderSorted:
+		sort.SliceStable(defs, func(i, j int) bool {
+			if defs[i].rank != defs[j].rank {
+				return defs[i].rank < defs[j].rank
+			}
+			if defs[i].name != defs[j].name {
+				return defs[i].name < defs[j].name
+			}
+			return !defs[i].isExtension && defs[j].isExtension
+		})
+	default:
+		sortBySource(defs)
+	}
+
+	return defs
+}
+
+// sortBySource orders defs by sources in order of first appearance, then by offset within each source.
+func sortBySource(defs []definition) {
+	sources := make(map[*ast.Source]int)
+	for _, def := range defs {
+		if def.position == nil {
+			continue
+		}
+		if _, ok := sources[def.position.Source]; !ok {
+			sources[def.position.Source] = len(sources)
+		}
+	}
+
+	for i := range defs {
+		if defs[i].position == nil {
+			defs[i].source, defs[i].offset = len(sources), i
+			continue
+		}
+		defs[i].source, defs[i].offset = sources[defs[i].position.Source], defs[i].position.Start
+	}
+
+	sort.SliceStable(defs, func(i, j int) bool {
+		if defs[i].source != defs[j].source {
+			return defs[i].source < defs[j].source
+		}
+		return defs[i].offset < defs[j].offset
+	})
+}
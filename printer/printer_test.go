@@ -0,0 +1,264 @@
+package printer
+
+import (
+	"bytes"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/parser"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"testing"
+)
+
+func TestConfig_Fprint(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		schema string
+		want   string
+	}{
+		{
+			name: "every definition in source order",
+			schema: `
+schema @d { query: Query mutation: Mutation }
+extend schema @e { subscription: Subscription }
+"scalar"
+scalar Time @specifiedBy(url: "https://example.com/time")
+extend scalar Time @d
+"""
+object
+  with two lines
+"""
+type User implements Node & Entity @key(fields: ["id", "name"]) {
+  "id" id: ID!
+  friends(first: Int = 10 @d, after: String): [User!]! @deprecated(reason: "use \"connections\"")
+}
+extend type User implements Named @d { name: String }
+interface Node implements Entity { id: ID! }
+extend interface Node @d
+union Result @d = User | Error
+extend union Result = Node
+enum Role { "admin" ADMIN @d USER }
+extend enum Role { GUEST }
+input Filter { limit: Int = 1 order: [Order!] = [{field: NAME, asc: true}] ratio: Float = 1.5e3 id: ID = null }
+extend input Filter @d
+directive @d(
+  "argument"
+  arg: String = "x"
+) repeatable on FIELD_DEFINITION | OBJECT
+`,
+			want: `schema @d {
+  query: Query
+  mutation: Mutation
+}
+
+extend schema @e {
+  subscription: Subscription
+}
+
+"scalar"
+scalar Time @specifiedBy(url: "https://example.com/time")
+
+extend scalar Time @d
+
+"""
+object
+  with two lines
+"""
+type User implements Node & Entity @key(fields: ["id", "name"]) {
+  "id"
+  id: ID!
+  friends(first: Int = 10 @d, after: String): [User!]! @deprecated(reason: "use \"connections\"")
+}
+
+extend type User implements Named @d {
+  name: String
+}
+
+interface Node implements Entity {
+  id: ID!
+}
+
+extend interface Node @d
+
+union Result @d = User | Error
+
+extend union Result = Node
+
+enum Role {
+  "admin"
+  ADMIN @d
+  USER
+}
+
+extend enum Role {
+  GUEST
+}
+
+input Filter {
+  limit: Int = 1
+  order: [Order!] = [{field: NAME, asc: true}]
+  ratio: Float = 1500.0
+  id: ID = null
+}
+
+extend input Filter @d
+
+directive @d(
+  "argument"
+  arg: String = "x"
+) repeatable on FIELD_DEFINITION | OBJECT
+`,
+		},
+		{
+			name:   "sorted order",
+			config: Config{Order: OrderSorted},
+			schema: `
+extend type B @d
+type B { b: Int }
+scalar A
+directive @z on FIELD
+directive @a on FIELD
+schema { query: B }
+`,
+			want: `schema {
+  query: B
+}
+
+directive @a on FIELD
+
+directive @z on FIELD
+
+scalar A
+
+type B {
+  b: Int
+}
+
+extend type B @d
+`,
+		},
+		{
+			name:   "indent with tabs",
+			config: Config{Indent: "\t"},
+			schema: `type Query { "multi\nline" field(
+"argument" arg: Int): Int }`,
+			want: "type Query {\n\t\"\"\"\n\tmulti\n\tline\n\t\"\"\"\n\tfield(\n\t\t\"argument\"\n\t\targ: Int\n\t): Int\n}\n",
+		},
+		{
+			name:   "block descriptions",
+			config: Config{DescriptionStyle: DescriptionStyleBlock},
+			schema: `"single line" scalar A
+"""
+  leading indentation is kept
+"""
+scalar B
+"\n  can not be a block string" scalar C`,
+			want: `"""
+single line
+"""
+scalar A
+
+"""
+leading indentation is kept
+"""
+scalar B
+
+"\n  can not be a block string"
+scalar C
+`,
+		},
+		{
+			name:   "single-line descriptions",
+			config: Config{DescriptionStyle: DescriptionStyleSingleLine},
+			schema: `"""
+  first
+    second
+"""
+scalar A`,
+			want: `"first\n  second"
+scalar A
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.ParseTypeSystemExtensionDocument(&ast.Source{Body: tt.schema})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			if err = tt.config.Fprint(&buf, doc); err != nil {
+				t.Fatalf("Fprint() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, buf.String()); diff != "" {
+				t.Errorf("Fprint() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFprint_RoundTrip(t *testing.T) {
+	schema := `
+"""
+description with \\ backslash
+  and indentation
+"""
+type Query {
+  users(
+    "a \\ backslash"
+    filter: Filter = {name: "é\t", ids: [1, 2]}
+  ): [User!] @deprecated
+}
+input Filter { name: String ids: [Int!] }
+"tab\tand \u0001 control\nwith \"quotes\"" scalar User
+`
+	doc, err := parser.ParseTypeSystemExtensionDocument(&ast.Source{Body: schema})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err = Fprint(&buf, doc); err != nil {
+		t.Fatalf("Fprint() error = %v", err)
+	}
+	reparsed, err := parser.ParseTypeSystemExtensionDocument(&ast.Source{Body: buf.String()})
+	if err != nil {
+		t.Fatalf("printed document does not parse: %v\n%s", err, buf.String())
+	}
+
+	// descriptions may be quoted differently, so compare their values.
+	ignoreRaw := cmpopts.IgnoreFields(ast.InputValueDefinition{}, "RawDefaultValue")
+	descriptions := cmp.FilterPath(func(p cmp.Path) bool {
+		sf, ok := p.Last().(cmp.StructField)
+		return ok && sf.Name() == "Description"
+	}, cmp.Transformer("description", func(raw string) string {
+		if raw == "" {
+			return ""
+		}
+		s, err := parser.StringValue(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}))
+	if diff := cmp.Diff(doc, reparsed, cmpopts.IgnoreTypes(&ast.Position{}), ignoreRaw, descriptions); diff != "" {
+		t.Errorf("round trip mismatch (-want +got):\n%s\n%s", diff, buf.String())
+	}
+}
+
+func TestFprint_InvalidDescription(t *testing.T) {
+	doc := &ast.TypeSystemExtensionDocument{
+		TypeDefinitions: []ast.TypeDefinition{
+			&ast.ScalarTypeDefinition{Description: "not quoted", Name: "Time"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Fprint(&buf, doc); err == nil {
+		t.Errorf("Fprint() error = nil, want error")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Fprint() wrote %q, want nothing", buf.String())
+	}
+}
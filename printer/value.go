@@ -0,0 +1,166 @@
+package printer
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/parser"
+	"strconv"
+	"strings"
+)
+
+// https://spec.graphql.org/October2021/#sec-Input-Values
+func (p *printer) printValue(v ast.Value) {
+	switch v := v.(type) {
+	case ast.Variable:
+		p.WriteString("$" + v.Name)
+	case ast.IntValue:
+		p.WriteString(strconv.FormatInt(v.Value, 10))
+	case ast.FloatValue:
+		p.WriteString(formatFloat(v.Value))
+	case ast.StringValue:
+		p.WriteString(quote(v.Value))
+	case ast.BooleanValue:
+		p.WriteString(strconv.FormatBool(v.Value))
+	case ast.NullValue:
+		p.WriteString("null")
+	case ast.EnumValue:
+		p.WriteString(v.Value)
+	case ast.ListValue:
+		p.WriteString("[")
+		for i, item := range v.Values {
+			if i > 0 {
+				p.WriteString(", ")
+			}
+			p.printValue(item)
+		}
+		p.WriteString("]")
+	case ast.ObjectValue:
+		p.WriteString("{")
+		for i, field := range v.Fields {
+			if i > 0 {
+				p.WriteString(", ")
+			}
+			p.WriteString(field.Name + ": ")
+			p.printValue(field.Value)
+		}
+		p.WriteString("}")
+	default:
+		p.setError(fmt.Errorf("printer: unsupported value %T", v))
+	}
+}
+
+// formatFloat formats f so that it is read back as a Float, not an Int.
+func formatFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+	return s
+}
+
+// quote returns s as a single-line string literal.
+//
+// https://spec.graphql.org/October2021/#StringValue
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// printDescription prints the description stored in raw, as written in the source, on its own lines.
+//
+// https://spec.graphql.org/October2021/#Description
+func (p *printer) printDescription(raw string) {
+	if raw == "" {
+		return
+	}
+
+	s, err := parser.StringValue(raw)
+	if err != nil {
+		p.setError(fmt.Errorf("printer: invalid description %s: %w", raw, err))
+		return
+	}
+
+	var block bool
+	switch p.DescriptionStyle {
+	case DescriptionStyleAuto:
+		block = strings.Contains(s, "\n") && canBlockQuote(s)
+	case DescriptionStyleBlock:
+		block = canBlockQuote(s)
+	}
+
+	p.writeIndent()
+	if !block {
+		p.WriteString(quote(s))
+		p.newline()
+		return
+	}
+
+	p.WriteString(`"""`)
+	p.newline()
+	for _, line := range strings.Split(s, "\n") {
+		if line != "" {
+			p.writeIndent()
+			p.WriteString(line)
+		}
+		p.newline()
+	}
+	p.writeIndent()
+	p.WriteString(`"""`)
+	p.newline()
+}
+
+// canBlockQuote reports whether s is read back unchanged from a block string.
+// block strings drop leading and trailing blank lines, remove common indentation,
+// normalize line terminators and can not hold control characters other than tab.
+// gogqllexer also rejects '"' in block strings, so such strings are not block quoted either.
+func canBlockQuote(s string) bool {
+	for _, r := range s {
+		if (r < 0x20 && r != '\t' && r != '\n') || r == 0x7f || r == '"' {
+			return false
+		}
+	}
+
+	lines := strings.Split(s, "\n")
+	if strings.Trim(lines[0], " \t") == "" || strings.Trim(lines[len(lines)-1], " \t") == "" {
+		return false
+	}
+	for _, line := range lines {
+		if line != "" && line[0] != ' ' && line[0] != '\t' {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatValue returns v as written in GraphQL syntax, e.g. `{ids: [1, 2]}`.
+func FormatValue(v ast.Value) string {
+	p := &printer{}
+	p.printValue(v)
+	return p.String()
+}
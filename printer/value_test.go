@@ -0,0 +1,48 @@
+package printer
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"testing"
+)
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value ast.Value
+		want  string
+	}{
+		{
+			name:  "float without fraction",
+			value: ast.FloatValue{Value: 2},
+			want:  "2.0",
+		},
+		{
+			name:  "float with exponent",
+			value: ast.FloatValue{Value: 1e21},
+			want:  "1e+21",
+		},
+		{
+			name:  "string with escape sequences",
+			value: ast.StringValue{Value: "quote\" backslash\\ newline\n control\x01 é"},
+			want:  `"quote\" backslash\\ newline\n control\u0001 é"`,
+		},
+		{
+			name: "nested object",
+			value: ast.ObjectValue{
+				Fields: []ast.ObjectField{
+					{Name: "ids", Value: ast.ListValue{Values: []ast.Value{ast.IntValue{Value: 1}, ast.Variable{Name: "id"}}}},
+					{Name: "role", Value: ast.EnumValue{Value: "ADMIN"}},
+					{Name: "deleted", Value: ast.NullValue{}},
+				},
+			},
+			want: `{ids: [1, $id], role: ADMIN, deleted: null}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatValue(tt.value); got != tt.want {
+				t.Errorf("FormatValue() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
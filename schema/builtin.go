@@ -1,8 +1,11 @@
-package validator
+package schema
 
 import "github.com/Sntree2mi8/gogqlparser/ast"
 
-var BultinTypeSystemExtensionDocument = &ast.TypeSystemExtensionDocument{
+// BuiltinTypeSystemExtensionDocument defines the scalars and directives every schema has.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Scalars.Built-in-Scalars
+var BuiltinTypeSystemExtensionDocument = &ast.TypeSystemExtensionDocument{
 	SchemaDefinitions: nil,
 	TypeDefinitions: []ast.TypeDefinition{
 		&ast.ScalarTypeDefinition{
@@ -63,13 +66,13 @@ var BultinTypeSystemExtensionDocument = &ast.TypeSystemExtensionDocument{
 					Name: "reason",
 					Type: ast.Type{
 						NamedType: "String",
-						NotNull:   true,
 					},
+					DefaultValue:    ast.StringValue{Value: "No longer supported"},
 					RawDefaultValue: `"No longer supported"`,
 				},
 			},
 			DirectiveLocations: []ast.DirectiveLocation{
-				ast.DirectiveLocationField,
+				ast.DirectiveLocationFieldDefinition,
 				ast.DirectiveLocationArgumentDefinition,
 				ast.DirectiveLocationInputFieldDefinition,
 				ast.DirectiveLocationEnumValue,
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"strings"
+)
+
+// Error reports a definition or an extension which can not be added to a Schema.
+type Error struct {
+	Message string
+	// Element names the definition, e.g. "User", "@auth" or "schema".
+	Element  string
+	Position *ast.Position
+}
+
+func (e *Error) Error() string {
+	if e.Position == nil {
+		return e.Message
+	}
+	return e.Position.String() + ": " + e.Message
+}
+
+// Errors holds every Error found while building a Schema.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e Errors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
@@ -0,0 +1,218 @@
+package schema
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"slices"
+)
+
+// ApplyExtensions returns a copy of doc in which every type and schema extension is folded into the definition it extends.
+// The returned document has no extensions left. Definitions of doc are never modified; extended definitions are copied.
+//
+// An extension which targets an undefined type or a type of another kind is skipped,
+// as is a field, enum value, union member or interface which is already there.
+// Each of them is reported in the returned Errors, along with the document where the rest is applied.
+// Schema extensions are applied to the first schema definition. A root operation type which is already set is kept
+// without an error, as the validator reports it.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Type-Extensions
+func ApplyExtensions(doc *ast.TypeSystemExtensionDocument) (*ast.TypeSystemExtensionDocument, Errors) {
+	applied := &ast.TypeSystemExtensionDocument{
+		SchemaDefinitions:    slices.Clone(doc.SchemaDefinitions),
+		TypeDefinitions:      slices.Clone(doc.TypeDefinitions),
+		DirectiveDefinitions: slices.Clone(doc.DirectiveDefinitions),
+	}
+
+	index := make(map[string]int, len(applied.TypeDefinitions))
+	for i, def := range applied.TypeDefinitions {
+		if _, ok := index[def.TypeName()]; !ok {
+			index[def.TypeName()] = i
+		}
+	}
+
+	var errs Errors
+	report := func(element string, pos *ast.Position, err error) {
+		errs = append(errs, &Error{Message: err.Error(), Element: element, Position: pos})
+	}
+
+	copied := make(map[int]bool)
+	for _, ext := range doc.TypeSystemExtensions {
+		i, ok := index[ext.TypeName()]
+		if !ok {
+			report(ext.TypeName(), ext.GetPosition(), fmt.Errorf("cannot extend undefined type: %s", ext.TypeName()))
+			continue
+		}
+		if !copied[i] {
+			applied.TypeDefinitions[i] = copyTypeDefinition(applied.TypeDefinitions[i])
+			copied[i] = true
+		}
+		for _, err := range extendType(applied.TypeDefinitions[i], ext) {
+			report(ext.TypeName(), ext.GetPosition(), err)
+		}
+	}
+
+	for _, ext := range doc.SchemaExtensions {
+		if len(applied.SchemaDefinitions) == 0 {
+			report("schema", ext.Position, fmt.Errorf("cannot extend undefined schema"))
+			continue
+		}
+		extendSchema(&applied.SchemaDefinitions[0], ext)
+	}
+
+	return applied, errs
+}
+
+// copyTypeDefinition returns a shallow copy of def.
+// slices are clipped so that appending to them does not write to the arrays of def.
+func copyTypeDefinition(def ast.TypeDefinition) ast.TypeDefinition {
+	switch def := def.(type) {
+	case *ast.ScalarTypeDefinition:
+		c := *def
+		c.Directives = slices.Clip(c.Directives)
+		return &c
+	case *ast.ObjectTypeDefinition:
+		c := *def
+		c.Directives = slices.Clip(c.Directives)
+		c.FieldDefinitions = slices.Clip(c.FieldDefinitions)
+		c.Interfaces = slices.Clip(c.Interfaces)
+		return &c
+	case *ast.InterfaceTypeDefinition:
+		c := *def
+		c.Directives = slices.Clip(c.Directives)
+		c.FieldDefinitions = slices.Clip(c.FieldDefinitions)
+		c.Interfaces = slices.Clip(c.Interfaces)
+		return &c
+	case *ast.UnionTypeDefinition:
+		c := *def
+		c.Directives = slices.Clip(c.Directives)
+		c.MemberTypes = slices.Clip(c.MemberTypes)
+		return &c
+	case *ast.EnumTypeDefinition:
+		c := *def
+		c.Directives = slices.Clip(c.Directives)
+		c.EnumValue = slices.Clip(c.EnumValue)
+		return &c
+	case *ast.InputObjectTypeDefinition:
+		c := *def
+		c.Directives = slices.Clip(c.Directives)
+		c.InputFields = slices.Clip(c.InputFields)
+		return &c
+	default:
+		return def
+	}
+}
+
+// extendType adds what ext defines to def, skipping what def already has.
+func extendType(def ast.TypeDefinition, ext ast.TypeSystemExtension) (errs []error) {
+	switch ext := ext.(type) {
+	case *ast.ScalarTypeExtension:
+		d, ok := def.(*ast.ScalarTypeDefinition)
+		if !ok {
+			return []error{kindMismatch(def, ext, ast.TypeDefinitionKindScalar)}
+		}
+		d.Directives = append(d.Directives, ext.Directives...)
+	case *ast.ObjectTypeExtension:
+		d, ok := def.(*ast.ObjectTypeDefinition)
+		if !ok {
+			return []error{kindMismatch(def, ext, ast.TypeDefinitionKindObject)}
+		}
+		d.Interfaces = appendInterfaces(d.Name, d.Interfaces, ext.ImplementInterfaces, &errs)
+		d.FieldDefinitions = appendFields(d.Name, d.FieldDefinitions, ext.FieldsDefinition, &errs)
+		d.Directives = append(d.Directives, ext.Directives...)
+	case *ast.InterfaceTypeExtension:
+		d, ok := def.(*ast.InterfaceTypeDefinition)
+		if !ok {
+			return []error{kindMismatch(def, ext, ast.TypeDefinitionKindInterface)}
+		}
+		d.Interfaces = appendInterfaces(d.Name, d.Interfaces, ext.ImplementInterfaces, &errs)
+		d.FieldDefinitions = appendFields(d.Name, d.FieldDefinitions, ext.FieldsDefinition, &errs)
+		d.Directives = append(d.Directives, ext.Directives...)
+	case *ast.UnionTypeExtension:
+		d, ok := def.(*ast.UnionTypeDefinition)
+		if !ok {
+			return []error{kindMismatch(def, ext, ast.TypeDefinitionKindUnion)}
+		}
+		for _, member := range ext.MemberTypes {
+			if slices.ContainsFunc(d.MemberTypes, func(t ast.Type) bool { return t.NamedType == member.NamedType }) {
+				errs = append(errs, fmt.Errorf("duplicate union member %s in extension of %s", member.NamedType, d.Name))
+				continue
+			}
+			d.MemberTypes = append(d.MemberTypes, member)
+		}
+		d.Directives = append(d.Directives, ext.Directives...)
+	case *ast.EnumTypeExtension:
+		d, ok := def.(*ast.EnumTypeDefinition)
+		if !ok {
+			return []error{kindMismatch(def, ext, ast.TypeDefinitionKindEnum)}
+		}
+		for _, v := range ext.EnumValue {
+			if slices.ContainsFunc(d.EnumValue, func(e ast.EnumValueDefinition) bool { return e.Value.Value == v.Value.Value }) {
+				errs = append(errs, fmt.Errorf("duplicate enum value %s.%s in extension", d.Name, v.Value.Value))
+				continue
+			}
+			d.EnumValue = append(d.EnumValue, v)
+		}
+		d.Directives = append(d.Directives, ext.Directives...)
+	case *ast.InputObjectTypeExtension:
+		d, ok := def.(*ast.InputObjectTypeDefinition)
+		if !ok {
+			return []error{kindMismatch(def, ext, ast.TypeDefinitionKindInputObject)}
+		}
+		for _, f := range ext.InputsFieldDefinition {
+			if slices.ContainsFunc(d.InputFields, func(e ast.InputValueDefinition) bool { return e.Name == f.Name }) {
+				errs = append(errs, fmt.Errorf("duplicate input field %s.%s in extension", d.Name, f.Name))
+				continue
+			}
+			d.InputFields = append(d.InputFields, f)
+		}
+		d.Directives = append(d.Directives, ext.Directives...)
+	}
+
+	return errs
+}
+
+func kindMismatch(def ast.TypeDefinition, ext ast.TypeSystemExtension, want ast.TypeDefinitionKind) error {
+	return fmt.Errorf("cannot extend %s as %s type, it is defined as %s type", ext.TypeName(), want, def.TypeDefinitionKind())
+}
+
+func appendInterfaces(typeName string, interfaces, added []string, errs *[]error) []string {
+	for _, name := range added {
+		if slices.Contains(interfaces, name) {
+			*errs = append(*errs, fmt.Errorf("duplicate interface %s in extension of %s", name, typeName))
+			continue
+		}
+		interfaces = append(interfaces, name)
+	}
+	return interfaces
+}
+
+func appendFields(typeName string, fields, added []*ast.FieldDefinition, errs *[]error) []*ast.FieldDefinition {
+	for _, f := range added {
+		if slices.ContainsFunc(fields, func(e *ast.FieldDefinition) bool { return e.Name == f.Name }) {
+			*errs = append(*errs, fmt.Errorf("duplicate field %s.%s in extension", typeName, f.Name))
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// extendSchema adds the root operation types ext defines to def, keeping those def already has.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Schema-Extension
+func extendSchema(def *ast.SchemaDefinition, ext ast.SchemaExtension) {
+	for _, op := range []struct {
+		root  **ast.RootOperationTypeDefinition
+		added *ast.RootOperationTypeDefinition
+	}{
+		{root: &def.Query, added: ext.Query},
+		{root: &def.Mutation, added: ext.Mutation},
+		{root: &def.Subscription, added: ext.Subscription},
+	} {
+		if op.added != nil && *op.root == nil {
+			*op.root = op.added
+		}
+	}
+
+	def.Directives = append(slices.Clip(def.Directives), ext.Directives...)
+}
@@ -0,0 +1,251 @@
+package schema
+
+import (
+	"bytes"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/printer"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func TestApplyExtensions(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "every kind of extension",
+			schema: `
+schema { query: Query }
+extend schema @a { mutation: Mutation }
+extend type Query implements Node @a { me: User }
+type Query { id: ID! }
+type Mutation { noop: Int }
+interface Node { id: ID! }
+extend interface Node implements Entity { name: String }
+interface Entity { name: String }
+type User { id: ID! }
+scalar Time
+extend scalar Time @a
+union Result = User
+extend union Result = Query
+enum Role { ADMIN }
+extend enum Role { USER }
+input Filter { id: ID }
+extend input Filter { name: String }
+`,
+			want: `schema @a {
+  query: Query
+  mutation: Mutation
+}
+
+type Query implements Node @a {
+  id: ID!
+  me: User
+}
+
+type Mutation {
+  noop: Int
+}
+
+interface Node implements Entity {
+  id: ID!
+  name: String
+}
+
+interface Entity {
+  name: String
+}
+
+type User {
+  id: ID!
+}
+
+scalar Time @a
+
+union Result = User | Query
+
+enum Role {
+  ADMIN
+  USER
+}
+
+input Filter {
+  id: ID
+  name: String
+}
+`,
+		},
+		{
+			name:   "extensions from several modules",
+			schema: `type Query { a: Int } extend type Query { b: Int } extend type Query { c: Int }`,
+			want: `type Query {
+  a: Int
+  b: Int
+  c: Int
+}
+`,
+		},
+		{
+			name:    "undefined type",
+			schema:  `extend type Query { a: Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "kind mismatch",
+			schema:  `type Query { a: Int } extend input Query { b: Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate field",
+			schema:  `type Query { a: Int } extend type Query { a: String }`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate field across extensions",
+			schema:  `type Query { a: Int } extend type Query { b: Int } extend type Query { b: Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate interface",
+			schema:  `interface Node { id: ID } type User implements Node { id: ID } extend type User implements Node`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate union member",
+			schema:  `type A { a: Int } union U = A extend union U = A`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate enum value",
+			schema:  `enum Role { ADMIN } extend enum Role { ADMIN }`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate input field",
+			schema:  `input Filter { id: ID } extend input Filter { id: ID }`,
+			wantErr: true,
+		},
+		{
+			name:    "undefined schema",
+			schema:  `type Query { a: Int } extend schema { query: Query }`,
+			wantErr: true,
+		},
+		{
+			name:   "root operation type already set is kept",
+			schema: `schema { query: Query } extend schema { query: Root } type Query { a: Int } type Root { a: Int }`,
+			want: `schema {
+  query: Query
+}
+
+type Query {
+  a: Int
+}
+
+type Root {
+  a: Int
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyExtensions(mustParse(t, tt.schema))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ApplyExtensions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(got.TypeSystemExtensions) != 0 || len(got.SchemaExtensions) != 0 {
+				t.Errorf("ApplyExtensions() left extensions %v %v", got.TypeSystemExtensions, got.SchemaExtensions)
+			}
+
+			var buf bytes.Buffer
+			if err := (&printer.Config{Order: printer.OrderSource}).Fprint(&buf, got); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("ApplyExtensions() got =\n%s\nwant =\n%s", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyExtensions_DoesNotModifyDocument(t *testing.T) {
+	doc := mustParse(t, `type Query { a: Int } extend type Query @a { b: Int }`)
+	query := doc.TypeDefinitions[0].(*ast.ObjectTypeDefinition)
+
+	applied, err := ApplyExtensions(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(query.FieldDefinitions) != 1 || len(query.Directives) != 0 {
+		t.Errorf("ApplyExtensions() modified the definition: %+v", query)
+	}
+	if got := applied.TypeDefinitions[0].(*ast.ObjectTypeDefinition); got == query || len(got.FieldDefinitions) != 2 {
+		t.Errorf("ApplyExtensions() got = %+v", got)
+	}
+	if len(doc.TypeSystemExtensions) != 1 {
+		t.Errorf("ApplyExtensions() removed extensions of the document")
+	}
+}
+
+func TestNew_AppliesExtensions(t *testing.T) {
+	s, err := New(mustParse(t, `
+type Query { a: Int }
+extend type Query implements Node { id: ID! }
+interface Node { id: ID! }
+extend scalar String @specifiedBy(url: "https://example.com")
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Field(s.Type("Query"), "id") == nil {
+		t.Errorf("Field(Query, id) = nil")
+	}
+	if got := typeNames(s.PossibleTypes(s.Type("Node"))); len(got) != 1 || got[0] != "Query" {
+		t.Errorf("PossibleTypes(Node) = %v, want [Query]", got)
+	}
+	if got := s.Type("String").GetDirectives(); len(got) != 1 {
+		t.Errorf("String directives = %v, want @specifiedBy", got)
+	}
+	if got := BuiltinTypeSystemExtensionDocument.TypeDefinitions[2].GetDirectives(); len(got) != 0 {
+		t.Errorf("New() modified builtin String: %v", got)
+	}
+}
+
+func TestApplyExtensions_CollectsErrors(t *testing.T) {
+	doc := mustParse(t, `type Query { a: Int }
+extend type Query { a: Int b: Int }
+extend type Missing { a: Int }
+extend schema { query: Query }
+`)
+
+	applied, errs := ApplyExtensions(doc)
+
+	type result struct {
+		Element string
+		Line    int
+	}
+	var got []result
+	for _, e := range errs {
+		got = append(got, result{Element: e.Element, Line: e.Position.Line})
+	}
+	want := []result{
+		{Element: "Query", Line: 2},
+		{Element: "Missing", Line: 3},
+		{Element: "schema", Line: 4},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ApplyExtensions() errors mismatch (-want +got):\n%s\n%v", diff, errs)
+	}
+
+	if fields := applied.TypeDefinitions[0].(*ast.ObjectTypeDefinition).FieldDefinitions; len(fields) != 2 {
+		t.Errorf("ApplyExtensions() fields = %d, want the non-duplicate field b applied", len(fields))
+	}
+}
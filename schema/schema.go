@@ -0,0 +1,223 @@
+// Package schema resolves the definitions of a type system document into a schema.
+package schema
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+// Schema indexes the definitions of a type system document by name.
+type Schema struct {
+	source *ast.TypeSystemExtensionDocument
+	doc    *ast.TypeSystemExtensionDocument
+
+	types      map[string]ast.TypeDefinition
+	directives map[string]*ast.DirectiveDefinition
+	fields     map[string]map[string]*ast.FieldDefinition
+
+	// implementations maps an interface name to the object and interface types which implement it.
+	implementations map[string][]ast.TypeDefinition
+}
+
+// New builds a Schema from doc.
+// Types and directives of BuiltinTypeSystemExtensionDocument are added unless doc defines them,
+// then the extensions of doc are applied with ApplyExtensions.
+// The types of IntrospectionTypeSystemExtensionDocument are resolvable by Type and Field unless doc defines them,
+// but they are left out of Document.
+// If doc defines a type or a directive twice or if an extension can not be applied,
+// New returns Errors holding every such problem along with the Schema, which keeps the first definition
+// of a type or a directive and skips the extensions which can not be applied.
+func New(doc *ast.TypeSystemExtensionDocument) (*Schema, error) {
+	s := &Schema{
+		source:          doc,
+		types:           make(map[string]ast.TypeDefinition, len(doc.TypeDefinitions)),
+		directives:      make(map[string]*ast.DirectiveDefinition, len(doc.DirectiveDefinitions)),
+		fields:          make(map[string]map[string]*ast.FieldDefinition),
+		implementations: make(map[string][]ast.TypeDefinition),
+	}
+
+	var errs Errors
+	s.doc, errs = ApplyExtensions(doc.Merge(builtinsNotIn(doc)))
+
+	for _, def := range s.doc.TypeDefinitions {
+		if _, ok := s.types[def.TypeName()]; ok {
+			errs = append(errs, &Error{
+				Message:  fmt.Sprintf("duplicate type definition: %s", def.TypeName()),
+				Element:  def.TypeName(),
+				Position: def.GetPosition(),
+			})
+			continue
+		}
+		s.types[def.TypeName()] = def
+	}
+
+	for i := range s.doc.DirectiveDefinitions {
+		def := &s.doc.DirectiveDefinitions[i]
+		if _, ok := s.directives[def.Name]; ok {
+			errs = append(errs, &Error{
+				Message:  fmt.Sprintf("duplicate directive definition: %s", def.Name),
+				Element:  "@" + def.Name,
+				Position: def.Position,
+			})
+			continue
+		}
+		s.directives[def.Name] = def
+	}
+
+	for _, def := range s.doc.TypeDefinitions {
+		if s.types[def.TypeName()] == def {
+			s.indexType(def)
+		}
+	}
+	for _, def := range IntrospectionTypeSystemExtensionDocument.TypeDefinitions {
+		if _, ok := s.types[def.TypeName()]; !ok {
+			s.types[def.TypeName()] = def
+			s.indexType(def)
+		}
+	}
+
+	if len(errs) > 0 {
+		return s, errs
+	}
+	return s, nil
+}
+
+// indexType indexes the fields of def and the interfaces it implements.
+func (s *Schema) indexType(def ast.TypeDefinition) {
+	var (
+		fields     []*ast.FieldDefinition
+		interfaces []string
+	)
+	switch def := def.(type) {
+	case *ast.ObjectTypeDefinition:
+		fields, interfaces = def.FieldDefinitions, def.Interfaces
+	case *ast.InterfaceTypeDefinition:
+		fields, interfaces = def.FieldDefinitions, def.Interfaces
+	default:
+		return
+	}
+
+	byName := make(map[string]*ast.FieldDefinition, len(fields))
+	for _, f := range fields {
+		if _, ok := byName[f.Name]; !ok {
+			byName[f.Name] = f
+		}
+	}
+	s.fields[def.TypeName()] = byName
+
+	for _, name := range interfaces {
+		s.implementations[name] = append(s.implementations[name], def)
+	}
+}
+
+// builtinsNotIn returns the builtin definitions which doc does not define.
+func builtinsNotIn(doc *ast.TypeSystemExtensionDocument) *ast.TypeSystemExtensionDocument {
+	defined := make(map[string]bool)
+	for _, def := range doc.TypeDefinitions {
+		defined[def.TypeName()] = true
+	}
+	for _, def := range doc.DirectiveDefinitions {
+		defined["@"+def.Name] = true
+	}
+
+	builtins := &ast.TypeSystemExtensionDocument{}
+	for _, def := range BuiltinTypeSystemExtensionDocument.TypeDefinitions {
+		if !defined[def.TypeName()] {
+			builtins.TypeDefinitions = append(builtins.TypeDefinitions, def)
+		}
+	}
+	for _, def := range BuiltinTypeSystemExtensionDocument.DirectiveDefinitions {
+		if !defined["@"+def.Name] {
+			builtins.DirectiveDefinitions = append(builtins.DirectiveDefinitions, def)
+		}
+	}
+	return builtins
+}
+
+// Source returns the document given to New, with its extensions.
+func (s *Schema) Source() *ast.TypeSystemExtensionDocument {
+	return s.source
+}
+
+// Document returns the document the schema was built from, including the builtin definitions and with its extensions applied.
+func (s *Schema) Document() *ast.TypeSystemExtensionDocument {
+	return s.doc
+}
+
+// Type returns the type definition named name, or nil if there is none.
+func (s *Schema) Type(name string) ast.TypeDefinition {
+	return s.types[name]
+}
+
+// Directive returns the directive definition named name without "@", or nil if there is none.
+func (s *Schema) Directive(name string) *ast.DirectiveDefinition {
+	return s.directives[name]
+}
+
+// QueryType returns the root type of query operations, or nil if the schema has none.
+func (s *Schema) QueryType() *ast.ObjectTypeDefinition {
+	return s.rootType(func(def *ast.SchemaDefinition) *ast.RootOperationTypeDefinition { return def.Query }, "Query")
+}
+
+// MutationType returns the root type of mutation operations, or nil if the schema has none.
+func (s *Schema) MutationType() *ast.ObjectTypeDefinition {
+	return s.rootType(func(def *ast.SchemaDefinition) *ast.RootOperationTypeDefinition { return def.Mutation }, "Mutation")
+}
+
+// SubscriptionType returns the root type of subscription operations, or nil if the schema has none.
+func (s *Schema) SubscriptionType() *ast.ObjectTypeDefinition {
+	return s.rootType(func(def *ast.SchemaDefinition) *ast.RootOperationTypeDefinition { return def.Subscription }, "Subscription")
+}
+
+// rootType returns the object type that the schema definition names with operation.
+// Without a schema definition, it is the object type named defaultName.
+//
+// https://spec.graphql.org/October2021/#sec-Root-Operation-Types.Default-Root-Operation-Type-Names
+func (s *Schema) rootType(operation func(def *ast.SchemaDefinition) *ast.RootOperationTypeDefinition, defaultName string) *ast.ObjectTypeDefinition {
+	name := defaultName
+	if len(s.doc.SchemaDefinitions) > 0 {
+		root := operation(&s.doc.SchemaDefinitions[0])
+		if root == nil {
+			return nil
+		}
+		name = root.Type
+	}
+
+	obj, _ := s.types[name].(*ast.ObjectTypeDefinition)
+	return obj
+}
+
+// PossibleTypes returns the object types which can be returned for a field of type abstract.
+// They are the members of a union, the object types implementing an interface and an object type itself.
+//
+// https://spec.graphql.org/October2021/#GetPossibleTypes()
+func (s *Schema) PossibleTypes(abstract ast.TypeDefinition) []*ast.ObjectTypeDefinition {
+	var possibleTypes []*ast.ObjectTypeDefinition
+	switch def := abstract.(type) {
+	case *ast.ObjectTypeDefinition:
+		possibleTypes = append(possibleTypes, def)
+	case *ast.UnionTypeDefinition:
+		for _, member := range def.MemberTypes {
+			if obj, ok := s.types[member.NamedType].(*ast.ObjectTypeDefinition); ok {
+				possibleTypes = append(possibleTypes, obj)
+			}
+		}
+	case *ast.InterfaceTypeDefinition:
+		for _, impl := range s.implementations[def.Name] {
+			if obj, ok := impl.(*ast.ObjectTypeDefinition); ok {
+				possibleTypes = append(possibleTypes, obj)
+			}
+		}
+	}
+	return possibleTypes
+}
+
+// Implementations returns the object and interface types which declare that they implement iface.
+func (s *Schema) Implementations(iface *ast.InterfaceTypeDefinition) []ast.TypeDefinition {
+	return s.implementations[iface.Name]
+}
+
+// Field returns the field named name of an object or interface type, or nil if there is none.
+func (s *Schema) Field(typ ast.TypeDefinition, name string) *ast.FieldDefinition {
+	return s.fields[typ.TypeName()][name]
+}
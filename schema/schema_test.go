@@ -0,0 +1,265 @@
+package schema
+
+import (
+	"errors"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/parser"
+	"github.com/google/go-cmp/cmp"
+	"slices"
+	"testing"
+)
+
+func mustParse(t *testing.T, body string) *ast.TypeSystemExtensionDocument {
+	t.Helper()
+	doc, err := parser.ParseTypeSystemExtensionDocument(&ast.Source{Name: "schema.graphql", Body: body})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func typeNames[T ast.TypeDefinition](defs []T) []string {
+	names := make([]string, len(defs))
+	for i, def := range defs {
+		names[i] = def.TypeName()
+	}
+	return names
+}
+
+const testSchema = `
+schema { query: Query mutation: Mutation }
+type Query { node(id: ID!): Node search: [SearchResult!]! }
+type Mutation { noop: Boolean }
+interface Node { id: ID! }
+interface Entity implements Node { id: ID! name: String }
+type User implements Entity & Node { id: ID! name: String }
+type Group implements Node { id: ID! }
+union SearchResult = User | Group
+scalar String
+directive @auth(role: String) on FIELD_DEFINITION
+`
+
+func TestNew(t *testing.T) {
+	s, err := New(mustParse(t, testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("type lookup", func(t *testing.T) {
+		if def, ok := s.Type("User").(*ast.ObjectTypeDefinition); !ok || def.Name != "User" {
+			t.Errorf("Type(User) = %v", s.Type("User"))
+		}
+		if def := s.Type("Missing"); def != nil {
+			t.Errorf("Type(Missing) = %v, want nil", def)
+		}
+	})
+
+	t.Run("builtins are added unless defined", func(t *testing.T) {
+		for _, name := range []string{"Int", "Float", "String", "Boolean", "ID"} {
+			if s.Type(name) == nil {
+				t.Errorf("Type(%s) = nil", name)
+			}
+		}
+		for _, name := range []string{"include", "skip", "deprecated", "specifiedBy", "auth"} {
+			if s.Directive(name) == nil {
+				t.Errorf("Directive(%s) = nil", name)
+			}
+		}
+
+		strings := 0
+		for _, def := range s.Document().TypeDefinitions {
+			if def.TypeName() == "String" {
+				strings++
+			}
+		}
+		if strings != 1 {
+			t.Errorf("String is defined %d times, want 1", strings)
+		}
+	})
+
+	t.Run("root types", func(t *testing.T) {
+		if got := s.QueryType(); got == nil || got.Name != "Query" {
+			t.Errorf("QueryType() = %v, want Query", got)
+		}
+		if got := s.MutationType(); got == nil || got.Name != "Mutation" {
+			t.Errorf("MutationType() = %v, want Mutation", got)
+		}
+		if got := s.SubscriptionType(); got != nil {
+			t.Errorf("SubscriptionType() = %v, want nil", got)
+		}
+	})
+
+	t.Run("possible types", func(t *testing.T) {
+		tests := []struct {
+			abstract string
+			want     []string
+		}{
+			{abstract: "Node", want: []string{"User", "Group"}},
+			{abstract: "Entity", want: []string{"User"}},
+			{abstract: "SearchResult", want: []string{"User", "Group"}},
+			{abstract: "User", want: []string{"User"}},
+			{abstract: "String", want: []string{}},
+		}
+		for _, tt := range tests {
+			if got := typeNames(s.PossibleTypes(s.Type(tt.abstract))); !slices.Equal(got, tt.want) {
+				t.Errorf("PossibleTypes(%s) = %v, want %v", tt.abstract, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("implementations", func(t *testing.T) {
+		node := s.Type("Node").(*ast.InterfaceTypeDefinition)
+		if got, want := typeNames(s.Implementations(node)), []string{"Entity", "User", "Group"}; !slices.Equal(got, want) {
+			t.Errorf("Implementations(Node) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("field lookup", func(t *testing.T) {
+		if f := s.Field(s.Type("Entity"), "name"); f == nil || f.Type.NamedType != "String" {
+			t.Errorf("Field(Entity, name) = %v", f)
+		}
+		if f := s.Field(s.Type("Query"), "node"); f == nil || len(f.ArgumentDefinition) != 1 {
+			t.Errorf("Field(Query, node) = %v", f)
+		}
+		if f := s.Field(s.Type("User"), "missing"); f != nil {
+			t.Errorf("Field(User, missing) = %v, want nil", f)
+		}
+		if f := s.Field(s.Type("SearchResult"), "id"); f != nil {
+			t.Errorf("Field(SearchResult, id) = %v, want nil", f)
+		}
+	})
+}
+
+func TestSchema_DefaultRootTypes(t *testing.T) {
+	tests := []struct {
+		name             string
+		schema           string
+		wantQuery        string
+		wantMutation     string
+		wantSubscription string
+	}{
+		{
+			name:             "without schema definition",
+			schema:           `type Query { a: Int } type Mutation { a: Int } type Subscription { a: Int }`,
+			wantQuery:        "Query",
+			wantMutation:     "Mutation",
+			wantSubscription: "Subscription",
+		},
+		{
+			name:      "without schema definition nor object type named Mutation",
+			schema:    `type Query { a: Int } scalar Mutation`,
+			wantQuery: "Query",
+		},
+		{
+			name:      "schema definition without default names",
+			schema:    `schema { query: Root } type Root { a: Int } type Mutation { a: Int }`,
+			wantQuery: "Root",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(mustParse(t, tt.schema))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			name := func(def *ast.ObjectTypeDefinition) string {
+				if def == nil {
+					return ""
+				}
+				return def.Name
+			}
+			if got := name(s.QueryType()); got != tt.wantQuery {
+				t.Errorf("QueryType() = %q, want %q", got, tt.wantQuery)
+			}
+			if got := name(s.MutationType()); got != tt.wantMutation {
+				t.Errorf("MutationType() = %q, want %q", got, tt.wantMutation)
+			}
+			if got := name(s.SubscriptionType()); got != tt.wantSubscription {
+				t.Errorf("SubscriptionType() = %q, want %q", got, tt.wantSubscription)
+			}
+		})
+	}
+}
+
+func TestSchema_IntrospectionTypes(t *testing.T) {
+	s, err := New(mustParse(t, `type Query { a: Int }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	td := s.Type("__Type")
+	if td == nil {
+		t.Fatal("Type(__Type) = nil")
+	}
+	if f := s.Field(td, "ofType"); f == nil || f.Type.NamedType != "__Type" {
+		t.Errorf("Field(__Type, ofType) = %v", f)
+	}
+	for _, def := range s.Document().TypeDefinitions {
+		if def.TypeName() == "__Type" {
+			t.Errorf("Document() contains %s", def.TypeName())
+		}
+	}
+}
+
+func TestNew_Duplicate(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+	}{
+		{
+			name:   "type",
+			schema: `scalar Time scalar Time`,
+		},
+		{
+			name:   "directive",
+			schema: `directive @a on FIELD directive @a on FIELD`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(mustParse(t, tt.schema)); err == nil {
+				t.Errorf("New() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestNew_Errors(t *testing.T) {
+	s, err := New(mustParse(t, `scalar Time
+type Time { a: Int }
+directive @a on FIELD
+directive @a on QUERY
+extend type Missing { a: Int }
+`))
+
+	var errs Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("New() error = %v, want Errors", err)
+	}
+	var got []string
+	for _, e := range errs {
+		got = append(got, e.Error())
+	}
+	want := []string{
+		"schema.graphql:5:1: cannot extend undefined type: Missing",
+		"schema.graphql:2:1: duplicate type definition: Time",
+		"schema.graphql:4:1: duplicate directive definition: a",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("New() errors mismatch (-want +got):\n%s", diff)
+	}
+
+	if s == nil {
+		t.Fatal("New() = nil, want the schema built leniently")
+	}
+	if td := s.Type("Time"); td == nil || td.TypeDefinitionKind() != ast.TypeDefinitionKindScalar {
+		t.Errorf("Type(Time) = %v, want the first definition", td)
+	}
+	if s.Field(s.Type("Time"), "a") != nil {
+		t.Errorf("Field(Time, a) is indexed from the duplicate definition")
+	}
+	if dd := s.Directive("a"); dd == nil || dd.Position.Line != 3 {
+		t.Errorf("Directive(a) = %v, want the first definition", dd)
+	}
+}
@@ -0,0 +1,155 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+// argumentsUsage is the arguments given to a field or a directive of an executable document, with their definitions.
+type argumentsUsage struct {
+	// element names the field or directive, e.g. "Query.user" or "@include", and description describes it in a message.
+	element     string
+	description string
+	position    *ast.Position
+	arguments   []ast.Argument
+	definitions []ast.InputValueDefinition
+}
+
+// eachArgumentsUsage calls visit with the arguments of every field and directive of the document whose definition is known.
+func (v *validator) eachArgumentsUsage(visit func(u argumentsUsage)) {
+	v.walkSelectionSets(func(parent ast.TypeDefinition, set ast.SelectionSet) {
+		for _, sel := range set {
+			f, ok := sel.(*ast.Field)
+			if !ok {
+				continue
+			}
+			if fd := v.fieldDefinition(parent, f.Name); fd != nil {
+				element := parent.TypeName() + "." + f.Name
+				visit(argumentsUsage{
+					element:     element,
+					description: "field " + element,
+					position:    f.Position,
+					arguments:   f.Arguments,
+					definitions: fd.ArgumentDefinition,
+				})
+			}
+		}
+	})
+
+	v.eachExecutableDirective(func(d ast.Directive) {
+		if dd := v.schema.Directive(d.Name); dd != nil {
+			visit(argumentsUsage{
+				element:     "@" + d.Name,
+				description: "directive @" + d.Name,
+				position:    d.Position,
+				arguments:   d.Arguments,
+				definitions: dd.ArgumentsDefinition,
+			})
+		}
+	})
+}
+
+// eachExecutableDirective calls visit with every directive applied in the document.
+func (v *validator) eachExecutableDirective(visit func(d ast.Directive)) {
+	each := func(directives []ast.Directive) {
+		for _, d := range directives {
+			visit(d)
+		}
+	}
+
+	for _, op := range v.document.OperationDefinitions {
+		each(op.Directives)
+		for _, vd := range op.VariableDefinitions {
+			each(vd.Directives)
+		}
+	}
+	for _, fd := range v.document.FragmentDefinitions {
+		each(fd.Directives)
+	}
+	v.eachSelection(func(sel ast.Selection) {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			each(sel.Directives)
+		case *ast.FragmentSpread:
+			each(sel.Directives)
+		case *ast.InlineFragment:
+			each(sel.Directives)
+		}
+	})
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-Argument-Names
+func (v *validator) validateArgumentNames() {
+	v.eachArgumentsUsage(func(u argumentsUsage) {
+		for _, arg := range u.arguments {
+			if findArgument(u.definitions, arg.Name) == nil {
+				v.report(u.element, arg.Position, "undefined argument %s of %s", arg.Name, u.description)
+			}
+		}
+	})
+}
+
+// validateArgumentUniqueness validates the arguments of every field and directive, including those not defined in the schema.
+// A field is named after its parent type, e.g. "Dog.name", unless the parent type is unknown.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Argument-Uniqueness
+func (v *validator) validateArgumentUniqueness() {
+	validate := func(element, description string, args []ast.Argument) {
+		given := make(map[string]bool, len(args))
+		for _, arg := range args {
+			if given[arg.Name] {
+				v.report(element, arg.Position, "argument %s of %s must be given only once", arg.Name, description)
+			}
+			given[arg.Name] = true
+		}
+	}
+
+	named := make(map[*ast.Field]bool)
+	v.walkSelectionSets(func(parent ast.TypeDefinition, set ast.SelectionSet) {
+		for _, sel := range set {
+			if f, ok := sel.(*ast.Field); ok {
+				named[f] = true
+				element := parent.TypeName() + "." + f.Name
+				validate(element, "field "+element, f.Arguments)
+			}
+		}
+	})
+	v.eachSelection(func(sel ast.Selection) {
+		if f, ok := sel.(*ast.Field); ok && !named[f] {
+			validate(f.Name, "field "+f.Name, f.Arguments)
+		}
+	})
+	v.eachExecutableDirective(func(d ast.Directive) {
+		validate("@"+d.Name, "directive @"+d.Name, d.Arguments)
+	})
+}
+
+// validateRequiredArguments validates that every non-null argument without a default value is given, and not as null.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Required-Arguments
+func (v *validator) validateRequiredArguments() {
+	v.eachArgumentsUsage(func(u argumentsUsage) {
+		for _, ad := range u.definitions {
+			if !isRequiredArgument(ad) {
+				continue
+			}
+			arg := findArgumentValue(u.arguments, ad.Name)
+			if arg == nil {
+				v.report(u.element, u.position, "argument %s of %s is required", ad.Name, u.description)
+				continue
+			}
+			if _, ok := arg.Value.(ast.NullValue); ok {
+				v.report(u.element, arg.Position, "argument %s of %s must not be null", ad.Name, u.description)
+			}
+		}
+	})
+}
+
+// findArgumentValue returns the argument named name of args, or nil if it is not given.
+func findArgumentValue(args []ast.Argument, name string) *ast.Argument {
+	for i := range args {
+		if args[i].Name == name {
+			return &args[i]
+		}
+	}
+	return nil
+}
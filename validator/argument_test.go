@@ -0,0 +1,72 @@
+package validator
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+const argumentTestSchema = `
+type Query { dog: Dog }
+enum DogCommand { SIT HEEL }
+type Dog {
+  name(format: String): String
+  doesKnowCommand(dogCommand: DogCommand!): Boolean!
+  isHouseTrained(atOtherHomes: Boolean! = true): Boolean!
+}
+directive @limit(first: Int!, after: String) on FIELD | FRAGMENT_SPREAD
+`
+
+func Test_validateArguments(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "valid arguments",
+			query: `{ dog { name doesKnowCommand(dogCommand: SIT) isHouseTrained ...F @limit(first: 1) } } fragment F on Dog { name(format: "x") @include(if: true) }`,
+		},
+		{
+			name:  "arguments must be defined on the field or directive",
+			query: `{ dog { name(style: "x") @skip(unless: false, if: true) } }`,
+			want: []string{
+				"undefined argument style of field Dog.name",
+				"undefined argument unless of directive @skip",
+			},
+		},
+		{
+			name:  "arguments must be unique",
+			query: `{ dog { name(format: "a", format: "b") @limit(first: 1, first: 2) } cat { name(a: 1, a: 2) } }`,
+			want: []string{
+				"argument format of field Dog.name must be given only once",
+				"argument a of field name must be given only once",
+				"argument first of directive @limit must be given only once",
+			},
+		},
+		{
+			name:  "required arguments must be given",
+			query: `{ dog { doesKnowCommand ...F } } fragment F on Dog { name @limit(after: "x") }`,
+			want: []string{
+				"argument dogCommand of field Dog.doesKnowCommand is required",
+				"argument first of directive @limit is required",
+			},
+		},
+		{
+			name:  "required arguments must not be null",
+			query: `{ dog { doesKnowCommand(dogCommand: null) isHouseTrained(atOtherHomes: null) } }`,
+			want:  []string{"argument dogCommand of field Dog.doesKnowCommand must not be null"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateExecutable(t, argumentTestSchema, tt.query,
+				(*validator).validateArgumentNames,
+				(*validator).validateArgumentUniqueness,
+				(*validator).validateRequiredArguments,
+			)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validate arguments mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/printer"
+)
+
+// validateDefaultValues validates that the default value of every argument and input field can be coerced to its type.
+// A default value given only as RawDefaultValue is not checked.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Input-Values-Coercion
+func (v *validator) validateDefaultValues() {
+	doc := v.schema.Document()
+
+	for _, td := range doc.TypeDefinitions {
+		switch td := td.(type) {
+		case *ast.ObjectTypeDefinition:
+			v.validateFieldArgumentDefaultValues(td.Name, td.FieldDefinitions)
+		case *ast.InterfaceTypeDefinition:
+			v.validateFieldArgumentDefaultValues(td.Name, td.FieldDefinitions)
+		case *ast.InputObjectTypeDefinition:
+			for _, f := range td.InputFields {
+				v.validateDefaultValue(td.Name+"."+f.Name, f)
+			}
+		}
+	}
+
+	for _, dd := range doc.DirectiveDefinitions {
+		for _, ad := range dd.ArgumentsDefinition {
+			v.validateDefaultValue(fmt.Sprintf("@%s(%s:)", dd.Name, ad.Name), ad)
+		}
+	}
+}
+
+func (v *validator) validateFieldArgumentDefaultValues(typeName string, fields []*ast.FieldDefinition) {
+	for _, f := range fields {
+		for _, ad := range f.ArgumentDefinition {
+			v.validateDefaultValue(fmt.Sprintf("%s.%s(%s:)", typeName, f.Name, ad.Name), ad)
+		}
+	}
+}
+
+// validateDefaultValue validates the default value of the argument or input field named element.
+func (v *validator) validateDefaultValue(element string, def ast.InputValueDefinition) {
+	if def.DefaultValue == nil {
+		return
+	}
+	// a type which is undefined or is not an input type is reported by the rule of the definition.
+	if inputType, err := v.isInputType(def.Type); err != nil || !inputType {
+		return
+	}
+
+	if err := v.validateValue(def.DefaultValue, def.Type); err != nil {
+		pos := valuePosition(def.DefaultValue)
+		if pos == nil {
+			pos = def.Position
+		}
+		v.report(element, pos, "invalid default value %s of %s: %s", printer.FormatValue(def.DefaultValue), element, err)
+	}
+}
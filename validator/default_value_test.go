@@ -0,0 +1,86 @@
+package validator
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func Test_validateDefaultValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   []string
+	}{
+		{
+			name: "valid default values",
+			schema: `
+type Query {
+  users(limit: Int = 10, ratio: Float = 1, id: ID = 1, role: Role = ADMIN, roles: [Role!] = ADMIN, filter: Filter = {role: USER}): Int
+  posts(ids: [[ID]] = [[1, "2"], null], after: String = null): Int
+}
+enum Role { ADMIN USER }
+input Filter { role: Role! name: String = "a" tags: [String] = [] }
+directive @limit(max: Int = 100, time: Time = "anything") on FIELD_DEFINITION
+scalar Time`,
+		},
+		{
+			name:   "The default value of a field argument must match its scalar type",
+			schema: `type Query { users(limit: Int = "ten"): Int }`,
+			want:   []string{`schema.graphql:1:33: invalid default value "ten" of Query.users(limit:): expected value of type Int, found "ten"`},
+		},
+		{
+			name:   "The default value must be a defined enum value",
+			schema: `type Query { users(role: Role = NOT_A_VALUE): Int } enum Role { ADMIN }`,
+			want:   []string{`schema.graphql:1:33: invalid default value NOT_A_VALUE of Query.users(role:): value NOT_A_VALUE does not exist in enum Role`},
+		},
+		{
+			name:   "The default value of a non-null type must not be null",
+			schema: `interface Node { id(format: String! = null): ID }`,
+			want:   []string{`schema.graphql:1:39: invalid default value null of Node.id(format:): expected value of type String!, found null`},
+		},
+		{
+			name:   "Every item of a list default value must match the item type",
+			schema: `type Query { users(ids: [Int!] = [1, null]): Int }`,
+			want:   []string{`schema.graphql:1:34: invalid default value [1, null] of Query.users(ids:): expected value of type Int!, found null`},
+		},
+		{
+			name:   "A single default value must match the item type of a list type",
+			schema: `type Query { users(ids: [Int] = true): Int }`,
+			want:   []string{`schema.graphql:1:33: invalid default value true of Query.users(ids:): expected value of type Int, found true`},
+		},
+		{
+			name:   "The default value of an input object must give its required fields",
+			schema: `input Filter { role: String! } type Query { users(filter: Filter = {}): Int }`,
+			want:   []string{`schema.graphql:1:68: invalid default value {} of Query.users(filter:): input field Filter.role of type String! is required`},
+		},
+		{
+			name:   "The default value of an input field must match its type",
+			schema: `input Filter { limit: Int = 1.5 }`,
+			want:   []string{`schema.graphql:1:29: invalid default value 1.5 of Filter.limit: expected value of type Int, found 1.5`},
+		},
+		{
+			name:   "The default value of a directive argument must match its type",
+			schema: `directive @limit(max: Int = {}) on FIELD_DEFINITION`,
+			want:   []string{`schema.graphql:1:29: invalid default value {} of @limit(max:): expected value of type Int, found {}`},
+		},
+		{
+			name:   "The default value of an undefined type is not checked",
+			schema: `type Query { users(limit: Limit = 1): Int }`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := validateSchema(mustParse(t, tt.schema), (*validator).validateDefaultValues)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validateDefaultValues() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
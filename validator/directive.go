@@ -3,7 +3,7 @@ package validator
 import (
 	"fmt"
 	"github.com/Sntree2mi8/gogqlparser/ast"
-	"slices"
+	"github.com/Sntree2mi8/gogqlparser/schema"
 	"strings"
 )
 
@@ -12,8 +12,8 @@ func (v *validator) isInputType(t ast.Type) (bool, error) {
 		return v.isInputType(*t.ListType)
 	}
 
-	td, ok := v.typeDefs[t.NamedType]
-	if !ok {
+	td := v.schema.Type(t.NamedType)
+	if td == nil {
 		return false, fmt.Errorf("undefined type: %s", t.NamedType)
 	}
 	switch td.TypeDefinitionKind() {
@@ -33,112 +33,142 @@ func getUnderlyingType(t ast.Type) ast.Type {
 	return t
 }
 
-func (v *validator) checkSelfDirectiveReferenceInDirective(self ast.DirectiveDefinition, d ast.Directive) (hasReference bool) {
-	if d.Name == self.Name {
-		return true
-	}
+func (v *validator) validateDirectiveDefinitions() {
+	for _, dd := range v.schema.Document().DirectiveDefinitions {
+		element := "@" + dd.Name
+		if strings.HasPrefix(dd.Name, "__") {
+			v.report(element, dd.Position, "directive name must not begins with \"__\": %s", dd.Name)
+		}
+
+		for _, ad := range dd.ArgumentsDefinition {
+			argElement := fmt.Sprintf("@%s(%s:)", dd.Name, ad.Name)
+			if strings.HasPrefix(ad.Name, "__") {
+				v.report(argElement, ad.Position, "argument name must not begins with \"__\": %s", ad.Name)
+			}
 
-	for _, ad := range v.directiveDefs[d.Name].ArgumentsDefinition {
-		for _, adDir := range ad.Directives {
-			return v.checkSelfDirectiveReferenceInDirective(self, adDir)
+			if inputType, err := v.isInputType(ad.Type); err != nil {
+				v.report(argElement, ad.Position, "%s", err)
+			} else if !inputType {
+				v.report(argElement, ad.Position, "argument %s must be input type (scalar, enum, input object)", ad.Name)
+			}
 		}
 	}
 
-	return false
+	v.validateDirectiveCycles()
 }
 
-func (v *validator) checkSelfDirectiveReferenceInType(self ast.DirectiveDefinition, t ast.Type) (hasReference bool) {
-	typeDef := v.typeDefs[getUnderlyingType(t).NamedType]
+// validateDirectiveCycles reports every directive which references itself, either by being applied to one of its arguments
+// or through the directives applied to the types of its arguments, to their enum values and input fields,
+// and transitively to the types of those input fields.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Type-System.Directives.Validation
+func (v *validator) validateDirectiveCycles() {
+	// reported holds the directives on an already reported cycle so that the cycle is reported once.
+	reported := make(map[string]bool)
+	for _, dd := range v.schema.Document().DirectiveDefinitions {
+		if reported[dd.Name] {
+			continue
+		}
 
-	// 自己参照を判定するこの関数自体がdirectiveの文脈からしか呼ばれないのでScalar, Enum, InputObject以外は考慮しない
-	switch typeDef.TypeDefinitionKind() {
-	case ast.TypeDefinitionKindScalar:
-		if slices.Contains(self.DirectiveLocations, ast.DirectiveLocationScalar) {
-			for _, td := range typeDef.GetDirectives() {
-				if v.checkSelfDirectiveReferenceInDirective(self, td) {
-					return true
-				}
-			}
+		f := &directiveCycleFinder{
+			schema:  v.schema,
+			target:  dd.Name,
+			visited: make(map[string]bool),
 		}
-		return false
-	case ast.TypeDefinitionKindEnum:
-		if slices.Contains(self.DirectiveLocations, ast.DirectiveLocationEnum) {
-			for _, td := range typeDef.GetDirectives() {
-				if v.checkSelfDirectiveReferenceInDirective(self, td) {
-					return true
-				}
-			}
+		if !f.directive(dd.Name) {
+			continue
 		}
-		if slices.Contains(self.DirectiveLocations, ast.DirectiveLocationEnumValue) {
-			enumTypeDef := typeDef.(*ast.EnumTypeDefinition)
-			for _, ev := range enumTypeDef.EnumValue {
-				for _, evd := range ev.Directives {
-					if v.checkSelfDirectiveReferenceInDirective(self, evd) {
-						return true
-					}
-				}
+
+		for _, step := range f.path {
+			// steps of arguments, e.g. "@a(x:)", are skipped.
+			if name, ok := strings.CutPrefix(step, "@"); ok && !strings.Contains(name, "(") {
+				reported[name] = true
 			}
 		}
-	case ast.TypeDefinitionKindInputObject:
-		if slices.Contains(self.DirectiveLocations, ast.DirectiveLocationInputObject) {
-			for _, td := range typeDef.GetDirectives() {
-				if v.checkSelfDirectiveReferenceInDirective(self, td) {
+		v.report("@"+dd.Name, dd.Position, "directive @%s must not reference itself: %s", dd.Name, strings.Join(f.path, " -> "))
+	}
+}
+
+// directiveCycleFinder searches a path of references from the directive named target back to itself.
+// Every directive and type is visited once, so that the search ends on cycles which do not pass through target.
+type directiveCycleFinder struct {
+	schema *schema.Schema
+	target string
+	// visited holds the visited types and directives, the latter prefixed with "@".
+	visited map[string]bool
+	// path holds the directives, their arguments and the elements they are applied to, from target to the visited element.
+	path []string
+}
+
+func (f *directiveCycleFinder) directive(name string) bool {
+	f.path = append(f.path, "@"+name)
+	if name == f.target && len(f.path) > 1 {
+		return true
+	}
+
+	if !f.visited["@"+name] {
+		f.visited["@"+name] = true
+		if dd := f.schema.Directive(name); dd != nil {
+			for _, ad := range dd.ArgumentsDefinition {
+				if f.appliedTo("@"+name+"("+ad.Name+":)", ad.Directives, &ad.Type) {
 					return true
 				}
 			}
 		}
-		if slices.Contains(self.DirectiveLocations, ast.DirectiveLocationInputFieldDefinition) {
-			inputObjectTypeDef := typeDef.(*ast.InputObjectTypeDefinition)
-			for _, ifd := range inputObjectTypeDef.InputFields {
-				for _, ifdd := range ifd.Directives {
-					if v.checkSelfDirectiveReferenceInDirective(self, ifdd) {
-						return true
-					}
-				}
-			}
-		}
-	default:
-		return false
 	}
 
+	f.path = f.path[:len(f.path)-1]
 	return false
 }
 
-func (v *validator) validateDirectiveDefinitions() error {
-	for _, dd := range v.doc.DirectiveDefinitions {
-		if strings.HasPrefix(dd.Name, "__") {
-			return fmt.Errorf("directive name must not begins with \"__\": %s", dd.Name)
+func (f *directiveCycleFinder) directives(directives []ast.Directive) bool {
+	for _, d := range directives {
+		if f.directive(d.Name) {
+			return true
 		}
+	}
+	return false
+}
 
-		canUseOnArgumentDefinition := slices.Contains(dd.DirectiveLocations, ast.DirectiveLocationArgumentDefinition)
-		for _, ad := range dd.ArgumentsDefinition {
-			if strings.HasPrefix(ad.Name, "__") {
-				return fmt.Errorf("argument name must not begins with \"__\": %s", ad.Name)
-			}
+func (f *directiveCycleFinder) typ(t ast.Type) bool {
+	name := getUnderlyingType(t).NamedType
+	if f.visited[name] {
+		return false
+	}
+	f.visited[name] = true
 
-			inputType, err := v.isInputType(ad.Type)
-			if err != nil {
-				return err
-			}
-			if !inputType {
-				return fmt.Errorf("argument %s must be input type (scalar, enum, input object)", ad.Name)
-			}
+	td := f.schema.Type(name)
+	if td == nil {
+		return false
+	}
+	if f.appliedTo(name, td.GetDirectives(), nil) {
+		return true
+	}
 
-			// typeにより間接的に自分自身をreferenceしていないかを確認する
-			if v.checkSelfDirectiveReferenceInType(dd, ad.Type) {
-				return fmt.Errorf("argument %s must not contain the use of a directive which references itself", ad.Name)
+	switch td := td.(type) {
+	case *ast.EnumTypeDefinition:
+		for _, ev := range td.EnumValue {
+			if f.appliedTo(name+"."+ev.Value.Value, ev.Directives, nil) {
+				return true
 			}
-
-			// argument definitionにより間接的に自分自身をreferenceしていないかを確認する
-			if canUseOnArgumentDefinition {
-				for _, adDir := range ad.Directives {
-					if v.checkSelfDirectiveReferenceInDirective(dd, adDir) {
-						return fmt.Errorf("directive %s must not contain the use of a directive which references itself", dd.Name)
-					}
-				}
+		}
+	case *ast.InputObjectTypeDefinition:
+		for _, field := range td.InputFields {
+			if f.appliedTo(name+"."+field.Name, field.Directives, &field.Type) {
+				return true
 			}
 		}
 	}
 
-	return nil
+	return false
+}
+
+// appliedTo follows the directives applied to element and, for an argument or an input field, its type t.
+func (f *directiveCycleFinder) appliedTo(element string, directives []ast.Directive, t *ast.Type) bool {
+	f.path = append(f.path, element)
+	if f.directives(directives) || (t != nil && f.typ(*t)) {
+		return true
+	}
+	f.path = f.path[:len(f.path)-1]
+	return false
 }
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/google/go-cmp/cmp"
 	"log"
 	"testing"
 )
@@ -250,11 +251,96 @@ func Test_validateDirectiveDefinitions(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			v, _ := newValidator(tt.args.doc)
-			if err := v.validateDirectiveDefinitions(); (err != nil) != tt.wantErr {
-				t.Errorf("validateDirectiveDefinitions() error = %v, wantErr %v", err, tt.wantErr)
+			errs, _ := validateSchema(tt.args.doc, (*validator).validateDirectiveDefinitions)
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateDirectiveDefinitions() errors = %v, wantErr %v", errs, tt.wantErr)
 			} else {
-				log.Println(err)
+				log.Println(errs)
+			}
+		})
+	}
+}
+
+func Test_validateDirectiveCycles(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   []string
+	}{
+		{
+			name:   "The directive must not be applied to its own argument",
+			schema: `directive @a(x: Int @a) on ARGUMENT_DEFINITION`,
+			want:   []string{"directive @a must not reference itself: @a -> @a(x:) -> @a"},
+		},
+		{
+			name:   "Every directive on every argument is followed",
+			schema: `directive @a(x: Int @b, y: Int @b @a) on ARGUMENT_DEFINITION directive @b on ARGUMENT_DEFINITION`,
+			want:   []string{"directive @a must not reference itself: @a -> @a(y:) -> @a"},
+		},
+		{
+			name:   "Mutually recursive directives are reported once",
+			schema: `directive @a(x: Int @b) on ARGUMENT_DEFINITION directive @b(y: Int @a) on ARGUMENT_DEFINITION`,
+			want:   []string{"directive @a must not reference itself: @a -> @a(x:) -> @b -> @b(y:) -> @a"},
+		},
+		{
+			name: "The directive must not reference itself through an input field",
+			schema: `
+directive @a(input: A) on ARGUMENT_DEFINITION
+directive @b(x: Int @a) on INPUT_FIELD_DEFINITION
+input A { input: String @b }`,
+			want: []string{"directive @a must not reference itself: @a -> @a(input:) -> A.input -> @b -> @b(x:) -> @a"},
+		},
+		{
+			name: "The directive must not reference itself through the type of an input field",
+			schema: `
+directive @a(input: [A!]) on INPUT_OBJECT
+input A { b: B }
+input B @a { c: Int }`,
+			want: []string{"directive @a must not reference itself: @a -> @a(input:) -> A.b -> B -> @a"},
+		},
+		{
+			name:   "The directive must not reference itself through an enum value",
+			schema: `directive @a(e: E) on ENUM_VALUE enum E { V @a }`,
+			want:   []string{"directive @a must not reference itself: @a -> @a(e:) -> E.V -> @a"},
+		},
+		{
+			name: "A cycle of types without the directive ends the search",
+			schema: `
+directive @a(input: A) on INPUT_FIELD_DEFINITION
+input A { b: B }
+input B { a: A c: Int @a }`,
+			want: []string{"directive @a must not reference itself: @a -> @a(input:) -> A.b -> B.c -> @a"},
+		},
+		{
+			name: "A cycle entered from another directive is found",
+			schema: `
+directive @e(input: A) on ARGUMENT_DEFINITION
+directive @d(input: B) on INPUT_FIELD_DEFINITION
+input A { f: B h: Int @d }
+input B { g: A }`,
+			want: []string{"directive @d must not reference itself: @d -> @d(input:) -> B.g -> A.h -> @d"},
+		},
+		{
+			name: "valid directives",
+			schema: `
+directive @a(x: Int @b, input: A) on ARGUMENT_DEFINITION
+directive @b(y: Int) on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+input A { b: B @b }
+input B { a: A }`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := validateSchema(mustParse(t, tt.schema), (*validator).validateDirectiveCycles)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Message)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validateDirectiveCycles() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
@@ -0,0 +1,108 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"slices"
+)
+
+// validateDirectiveUsages validates the directives applied to every element of the schema.
+func (v *validator) validateDirectiveUsages() {
+	doc := v.schema.Document()
+
+	for _, sd := range doc.SchemaDefinitions {
+		v.validateDirectives(sd.Directives, ast.DirectiveLocationSchema, "schema")
+	}
+
+	for _, td := range doc.TypeDefinitions {
+		switch td := td.(type) {
+		case *ast.ScalarTypeDefinition:
+			v.validateDirectives(td.Directives, ast.DirectiveLocationScalar, td.Name)
+		case *ast.ObjectTypeDefinition:
+			v.validateDirectives(td.Directives, ast.DirectiveLocationObject, td.Name)
+			v.validateFieldDirectives(td.Name, td.FieldDefinitions)
+		case *ast.InterfaceTypeDefinition:
+			v.validateDirectives(td.Directives, ast.DirectiveLocationInterface, td.Name)
+			v.validateFieldDirectives(td.Name, td.FieldDefinitions)
+		case *ast.UnionTypeDefinition:
+			v.validateDirectives(td.Directives, ast.DirectiveLocationUnion, td.Name)
+		case *ast.EnumTypeDefinition:
+			v.validateDirectives(td.Directives, ast.DirectiveLocationEnum, td.Name)
+			for _, ev := range td.EnumValue {
+				v.validateDirectives(ev.Directives, ast.DirectiveLocationEnumValue, td.Name+"."+ev.Value.Value)
+			}
+		case *ast.InputObjectTypeDefinition:
+			v.validateDirectives(td.Directives, ast.DirectiveLocationInputObject, td.Name)
+			for _, f := range td.InputFields {
+				v.validateDirectives(f.Directives, ast.DirectiveLocationInputFieldDefinition, td.Name+"."+f.Name)
+			}
+		}
+	}
+
+	for _, dd := range doc.DirectiveDefinitions {
+		for _, ad := range dd.ArgumentsDefinition {
+			v.validateDirectives(ad.Directives, ast.DirectiveLocationArgumentDefinition, fmt.Sprintf("@%s(%s:)", dd.Name, ad.Name))
+		}
+	}
+}
+
+func (v *validator) validateFieldDirectives(typeName string, fields []*ast.FieldDefinition) {
+	for _, f := range fields {
+		v.validateDirectives(f.Directives, ast.DirectiveLocationFieldDefinition, typeName+"."+f.Name)
+		for _, ad := range f.ArgumentDefinition {
+			v.validateDirectives(ad.Directives, ast.DirectiveLocationArgumentDefinition, fmt.Sprintf("%s.%s(%s:)", typeName, f.Name, ad.Name))
+		}
+	}
+}
+
+// validateDirectives validates directives applied at loc of the schema element named element.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Validation.Directives
+func (v *validator) validateDirectives(directives []ast.Directive, loc ast.DirectiveLocation, element string) {
+	used := make(map[string]bool, len(directives))
+	for _, d := range directives {
+		dd := v.schema.Directive(d.Name)
+		if dd == nil {
+			v.report(element, d.Position, "undefined directive @%s on %s", d.Name, element)
+			continue
+		}
+
+		if !slices.Contains(dd.DirectiveLocations, loc) {
+			v.report(element, d.Position, "directive @%s can not be used on %s, which is %s", d.Name, element, loc)
+		}
+
+		if used[d.Name] && !dd.IsRepeatable {
+			v.report(element, d.Position, "directive @%s must not be used twice on %s because it is not repeatable", d.Name, element)
+		}
+		used[d.Name] = true
+
+		v.validateDirectiveArguments(d, dd, element)
+	}
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-Validation.Arguments
+func (v *validator) validateDirectiveArguments(d ast.Directive, dd *ast.DirectiveDefinition, element string) {
+	given := make(map[string]bool, len(d.Arguments))
+	for _, arg := range d.Arguments {
+		if given[arg.Name] {
+			v.report(element, arg.Position, "argument %s of @%s on %s must be given only once", arg.Name, d.Name, element)
+			continue
+		}
+		given[arg.Name] = true
+
+		ad := findArgument(dd.ArgumentsDefinition, arg.Name)
+		if ad == nil {
+			v.report(element, arg.Position, "undefined argument %s of @%s on %s", arg.Name, d.Name, element)
+			continue
+		}
+		if err := v.validateValue(arg.Value, ad.Type); err != nil {
+			v.report(element, arg.Position, "argument %s of @%s on %s: %s", arg.Name, d.Name, element, err)
+		}
+	}
+
+	for _, ad := range dd.ArgumentsDefinition {
+		if !given[ad.Name] && isRequiredArgument(ad) {
+			v.report(element, d.Position, "argument %s of @%s on %s is required", ad.Name, d.Name, element)
+		}
+	}
+}
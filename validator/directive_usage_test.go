@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"testing"
+)
+
+func Test_validateDirectiveUsages(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		wantErr bool
+	}{
+		{
+			name: "valid usages",
+			schema: `
+schema @meta(tags: "one") { query: Query }
+type Query @meta(tags: ["a", "b"], filter: {role: ADMIN, ids: [1, "2"]}) {
+  old: Int @deprecated
+  older(arg: Int @deprecated(reason: null)): Int @deprecated(reason: "use new") @meta @meta
+}
+scalar Time @specifiedBy(url: "https://example.com/time")
+enum Role { ADMIN @deprecated }
+input Filter { role: Role ids: [ID!] limit: Float = 1 }
+directive @meta(tags: [String!], filter: Filter, weight: Float = 0.5) repeatable on SCHEMA | OBJECT | FIELD_DEFINITION
+`,
+		},
+		{
+			name:    "The directive must be defined",
+			schema:  `type Query { a: Int @deprecatd }`,
+			wantErr: true,
+		},
+		{
+			name:    "The directive must be used at one of its locations",
+			schema:  `type Query @deprecated { a: Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "The directive on an enum value must be used at one of its locations",
+			schema:  `enum Role { ADMIN @specifiedBy(url: "") }`,
+			wantErr: true,
+		},
+		{
+			name:    "The directive on a directive argument must be used at one of its locations",
+			schema:  `directive @a(arg: Int @specifiedBy(url: "")) on FIELD`,
+			wantErr: true,
+		},
+		{
+			name:    "The non-repeatable directive must not be used twice",
+			schema:  `type Query { a: Int @deprecated @deprecated }`,
+			wantErr: true,
+		},
+		{
+			name:    "The non-repeatable directive must not be used twice through extensions",
+			schema:  `scalar Time @specifiedBy(url: "a") extend scalar Time @specifiedBy(url: "b")`,
+			wantErr: true,
+		},
+		{
+			name:    "The argument must be defined",
+			schema:  `type Query { a: Int @deprecated(why: "x") }`,
+			wantErr: true,
+		},
+		{
+			name:    "The argument must be unique",
+			schema:  `type Query { a: Int @deprecated(reason: "x", reason: "y") }`,
+			wantErr: true,
+		},
+		{
+			name:    "The required argument must be given",
+			schema:  `scalar Time @specifiedBy`,
+			wantErr: true,
+		},
+		{
+			name:    "The argument must not be null when it is non-null",
+			schema:  `scalar Time @specifiedBy(url: null)`,
+			wantErr: true,
+		},
+		{
+			name:    "The argument value must fit the scalar type",
+			schema:  `type Query { a: Int @deprecated(reason: 1) }`,
+			wantErr: true,
+		},
+		{
+			name:    "The Int argument value must fit in 32 bits",
+			schema:  `type Query @d(n: 2147483648) { a: Int } directive @d(n: Int) on OBJECT`,
+			wantErr: true,
+		},
+		{
+			name:    "The argument value must be a value of the enum",
+			schema:  `type Query @d(role: GUEST) { a: Int } directive @d(role: Role) on OBJECT enum Role { ADMIN }`,
+			wantErr: true,
+		},
+		{
+			name:    "The list item must fit the item type",
+			schema:  `type Query @d(ids: [1, true]) { a: Int } directive @d(ids: [Int]) on OBJECT`,
+			wantErr: true,
+		},
+		{
+			name:    "The input object value must not have undefined fields",
+			schema:  `type Query @d(f: {name: "x"}) { a: Int } directive @d(f: Filter) on OBJECT input Filter { id: ID }`,
+			wantErr: true,
+		},
+		{
+			name:    "The input object value must have required fields",
+			schema:  `type Query @d(f: {}) { a: Int } directive @d(f: Filter) on OBJECT input Filter { id: ID! }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := validateSchema(mustParse(t, tt.schema), (*validator).validateDirectiveUsages)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateDirectiveUsages() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+func (v *validator) validateEnumTypes() {
+	for _, td := range v.schema.Document().TypeDefinitions {
+		if td, ok := td.(*ast.EnumTypeDefinition); ok {
+			v.validateEnumTypeDefinition(td)
+		}
+	}
+}
+
+// https://spec.graphql.org/October2021/#sec-Enums.Type-Validation
+func (v *validator) validateEnumTypeDefinition(td *ast.EnumTypeDefinition) {
+	if len(td.EnumValue) == 0 {
+		v.report(td.Name, td.Position, "enum %s must define one or more values", td.Name)
+		return
+	}
+
+	values := make(map[string]bool, len(td.EnumValue))
+	for _, ev := range td.EnumValue {
+		name := ev.Value.Value
+		element := td.Name + "." + name
+		if values[name] {
+			v.report(element, ev.Position, "enum value must be unique: %s", element)
+		}
+		values[name] = true
+
+		switch name {
+		case "true", "false", "null":
+			v.report(element, ev.Position, "enum value must not be true, false or null: %s", element)
+		}
+	}
+}
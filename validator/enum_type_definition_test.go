@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"testing"
+)
+
+func Test_validateEnumTypeDefinition(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		doc     *ast.TypeSystemExtensionDocument
+		wantErr bool
+	}{
+		{
+			name:   "valid enum",
+			schema: `enum Role { ADMIN USER }`,
+		},
+		{
+			name: "The enum must define one or more values",
+			doc: &ast.TypeSystemExtensionDocument{
+				TypeDefinitions: []ast.TypeDefinition{
+					&ast.EnumTypeDefinition{Name: "Role"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "The enum values must be unique",
+			schema:  `enum Role { ADMIN ADMIN }`,
+			wantErr: true,
+		},
+		{
+			name:    "The enum value must not be true",
+			schema:  `enum Bool { true }`,
+			wantErr: true,
+		},
+		{
+			name:    "The enum value must not be null",
+			schema:  `enum Nullable { VALUE null }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := tt.doc
+			if doc == nil {
+				doc = mustParse(t, tt.schema)
+			}
+			errs, err := validateSchema(doc, (*validator).validateEnumTypes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateEnumTypes() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
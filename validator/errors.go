@@ -0,0 +1,85 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"strings"
+)
+
+// Rule identifiers of Error.
+const (
+	// RuleSchema reports a problem found while building a schema from the document,
+	// e.g. a type defined twice or an extension of an undefined type.
+	RuleSchema               = "Schema"
+	RuleRootOperationTypes   = "RootOperationTypes"
+	RuleTypeNames            = "TypeNames"
+	RuleDirectiveDefinitions = "DirectiveDefinitions"
+	RuleObjectTypes          = "ObjectTypes"
+	RuleInterfaceTypes       = "InterfaceTypes"
+	RuleUnionTypes           = "UnionTypes"
+	RuleEnumTypes            = "EnumTypes"
+	RuleInputObjectTypes     = "InputObjectTypes"
+	RuleInputObjectCycles    = "InputObjectCycles"
+	RuleDirectiveUsages      = "DirectiveUsages"
+	RuleDefaultValues        = "DefaultValues"
+)
+
+// Rule identifiers of Error reported by an ExecutableRule.
+const (
+	RuleOperationNameUniqueness          = "OperationNameUniqueness"
+	RuleLoneAnonymousOperation           = "LoneAnonymousOperation"
+	RuleSubscriptionSingleRootField      = "SubscriptionSingleRootField"
+	RuleFieldSelections                  = "FieldSelections"
+	RuleLeafFieldSelections              = "LeafFieldSelections"
+	RuleFragmentNameUniqueness           = "FragmentNameUniqueness"
+	RuleFragmentTypeConditions           = "FragmentTypeConditions"
+	RuleFragmentsMustBeUsed              = "FragmentsMustBeUsed"
+	RuleFragmentSpreadTargetDefined      = "FragmentSpreadTargetDefined"
+	RuleFragmentSpreadsMustNotFormCycles = "FragmentSpreadsMustNotFormCycles"
+	RuleFragmentSpreadIsPossible         = "FragmentSpreadIsPossible"
+	RuleOverlappingFieldsCanBeMerged     = "OverlappingFieldsCanBeMerged"
+	RuleArgumentNames                    = "ArgumentNames"
+	RuleArgumentUniqueness               = "ArgumentUniqueness"
+	RuleRequiredArguments                = "RequiredArguments"
+	RuleVariableUniqueness               = "VariableUniqueness"
+	RuleVariablesAreInputTypes           = "VariablesAreInputTypes"
+	RuleAllVariableUsesDefined           = "AllVariableUsesDefined"
+	RuleAllVariablesUsed                 = "AllVariablesUsed"
+	RuleAllVariableUsagesAreAllowed      = "AllVariableUsagesAreAllowed"
+)
+
+// Error is a violation of a validation rule.
+type Error struct {
+	// Rule identifies the violated rule, e.g. RuleObjectTypes.
+	Rule    string
+	Message string
+	// Element names the offending schema element, e.g. "User", "User.name", "User.name(format:)" or "@auth".
+	// For an executable document it names the offending operation or fragment, or the field with the type it is selected on.
+	Element  string
+	Position *ast.Position
+}
+
+func (e *Error) Error() string {
+	if e.Position == nil {
+		return e.Message
+	}
+	return e.Position.String() + ": " + e.Message
+}
+
+// Errors holds every Error found by a validation, in the order they are found.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e Errors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
@@ -0,0 +1,66 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+// validateFieldSelections validates that every selected field is defined on the type it is selected on.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Field-Selections
+func (v *validator) validateFieldSelections() {
+	for _, op := range v.document.OperationDefinitions {
+		if v.rootOperationType(op) == nil {
+			v.report(operationElement(op), op.Position, "schema does not define %s root operation type", op.OperationType)
+		}
+	}
+
+	v.walkSelectionSets(func(parent ast.TypeDefinition, set ast.SelectionSet) {
+		for _, sel := range set {
+			f, ok := sel.(*ast.Field)
+			if !ok || v.fieldDefinition(parent, f.Name) != nil {
+				continue
+			}
+			element := parent.TypeName() + "." + f.Name
+			if parent.TypeDefinitionKind() == ast.TypeDefinitionKindUnion {
+				v.report(element, f.Position, "field %s is not defined on %s, which is union type; select it in a fragment on a member type", f.Name, parent.TypeName())
+				continue
+			}
+			v.report(element, f.Position, "field %s is not defined on %s", f.Name, parent.TypeName())
+		}
+	})
+}
+
+// validateLeafFieldSelections validates that a field of a scalar or enum type has no selection set
+// and that a field of an object, interface or union type has one.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Leaf-Field-Selections
+func (v *validator) validateLeafFieldSelections() {
+	v.walkSelectionSets(func(parent ast.TypeDefinition, set ast.SelectionSet) {
+		for _, sel := range set {
+			f, ok := sel.(*ast.Field)
+			if !ok {
+				continue
+			}
+			fd := v.fieldDefinition(parent, f.Name)
+			if fd == nil {
+				continue
+			}
+			td := v.schema.Type(getUnderlyingType(fd.Type).NamedType)
+			if td == nil {
+				continue
+			}
+
+			element := parent.TypeName() + "." + f.Name
+			switch td.TypeDefinitionKind() {
+			case ast.TypeDefinitionKindScalar, ast.TypeDefinitionKindEnum:
+				if len(f.SelectionSet) > 0 {
+					v.report(element, f.Position, "field %s of %s type %s must not have a selection set", f.ResponseKey(), td.TypeDefinitionKind(), td.TypeName())
+				}
+			case ast.TypeDefinitionKindObject, ast.TypeDefinitionKindInterface, ast.TypeDefinitionKindUnion:
+				if len(f.SelectionSet) == 0 {
+					v.report(element, f.Position, "field %s of %s type %s must have a selection set", f.ResponseKey(), td.TypeDefinitionKind(), td.TypeName())
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+const fieldSelectionTestSchema = `
+type Query { pet: Pet search: [SearchResult!]! color: Color }
+interface Pet { name: String! }
+type Dog implements Pet { name: String! barks: Boolean! owner: Human }
+type Human { name: String! }
+union SearchResult = Dog | Human
+enum Color { RED }
+`
+
+func Test_validateFieldSelections(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "fields defined on the parent type",
+			query: `{ pet { name ... on Dog { barks owner { name } } } search { __typename ... on Human { name } } }`,
+		},
+		{
+			name:  "undefined fields of operations, fields and fragments",
+			query: `{ pets pet { barks } } fragment F on Dog { owner { age } }`,
+			want: []string{
+				"field pets is not defined on Query",
+				"field barks is not defined on Pet",
+				"field age is not defined on Human",
+			},
+		},
+		{
+			name:  "only __typename is defined on a union type",
+			query: `{ search { __typename name } }`,
+			want:  []string{"field name is not defined on SearchResult, which is union type; select it in a fragment on a member type"},
+		},
+		{
+			name:  "__schema and __type are defined only on the query root type",
+			query: `{ __schema { types { name } } __type(name: "Dog") { fields { name } } pet { __schema { description } } }`,
+			want:  []string{"field __schema is not defined on Pet"},
+		},
+		{
+			name:  "an operation without root operation type",
+			query: `mutation { a }`,
+			want:  []string{"schema does not define mutation root operation type"},
+		},
+		{
+			name:  "fragments on unknown types are skipped",
+			query: `{ ... on Cat { meows } } fragment F on Cat { meows }`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateExecutable(t, fieldSelectionTestSchema, tt.query, (*validator).validateFieldSelections)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validateFieldSelections() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_validateLeafFieldSelections(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "leaf and composite selections",
+			query: `{ color pet { name } search { __typename } __schema { queryType { name } } }`,
+		},
+		{
+			name:  "leaf fields must not have a selection set",
+			query: `{ color { name } pet { name { length } } }`,
+			want: []string{
+				"field color of enum type Color must not have a selection set",
+				"field name of scalar type String must not have a selection set",
+			},
+		},
+		{
+			name:  "composite fields must have a selection set",
+			query: `{ p: pet search __schema } fragment F on Dog { owner }`,
+			want: []string{
+				"field p of interface type Pet must have a selection set",
+				"field search of union type SearchResult must have a selection set",
+				"field __schema of object type __Schema must have a selection set",
+				"field owner of object type Human must have a selection set",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateExecutable(t, fieldSelectionTestSchema, tt.query, (*validator).validateLeafFieldSelections)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validateLeafFieldSelections() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"slices"
+	"strings"
+)
+
+// eachSelection calls visit with every selection of the operations and fragment definitions of the document,
+// including the selections nested in fields and inline fragments. Fragment spreads are not followed.
+func (v *validator) eachSelection(visit func(sel ast.Selection)) {
+	var walk func(set ast.SelectionSet)
+	walk = func(set ast.SelectionSet) {
+		for _, sel := range set {
+			visit(sel)
+			switch sel := sel.(type) {
+			case *ast.Field:
+				walk(sel.SelectionSet)
+			case *ast.InlineFragment:
+				walk(sel.SelectionSet)
+			}
+		}
+	}
+
+	for _, op := range v.document.OperationDefinitions {
+		walk(op.SelectionSet)
+	}
+	for _, fd := range v.document.FragmentDefinitions {
+		walk(fd.SelectionSet)
+	}
+}
+
+// fragmentSpreads returns the fragment spreads of set, including those nested in fields and inline fragments.
+func fragmentSpreads(set ast.SelectionSet) []*ast.FragmentSpread {
+	var spreads []*ast.FragmentSpread
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			spreads = append(spreads, fragmentSpreads(sel.SelectionSet)...)
+		case *ast.FragmentSpread:
+			spreads = append(spreads, sel)
+		case *ast.InlineFragment:
+			spreads = append(spreads, fragmentSpreads(sel.SelectionSet)...)
+		}
+	}
+	return spreads
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-Fragment-Name-Uniqueness
+func (v *validator) validateFragmentNameUniqueness() {
+	defined := make(map[string]bool, len(v.document.FragmentDefinitions))
+	for _, fd := range v.document.FragmentDefinitions {
+		if defined[fd.Name] {
+			v.report(fd.Name, fd.Position, "fragment %s must be defined only once", fd.Name)
+			continue
+		}
+		defined[fd.Name] = true
+	}
+}
+
+// validateFragmentTypeConditions validates that the type condition of every fragment definition and inline fragment
+// names a defined object, interface or union type.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Fragment-Spread-Type-Existence
+// Reference: https://spec.graphql.org/October2021/#sec-Fragments-On-Composite-Types
+func (v *validator) validateFragmentTypeConditions() {
+	validate := func(element, fragment string, pos *ast.Position, typeCondition string) {
+		td := v.schema.Type(typeCondition)
+		if td == nil {
+			v.report(element, pos, "undefined type %s in type condition of %s", typeCondition, fragment)
+			return
+		}
+		if v.compositeType(typeCondition) == nil {
+			v.report(element, pos, "type condition of %s must be object, interface or union type, %s is %s type", fragment, typeCondition, td.TypeDefinitionKind())
+		}
+	}
+
+	for _, fd := range v.document.FragmentDefinitions {
+		validate(fd.Name, "fragment "+fd.Name, fd.Position, fd.TypeCondition)
+	}
+	v.eachSelection(func(sel ast.Selection) {
+		if f, ok := sel.(*ast.InlineFragment); ok && f.TypeCondition != "" {
+			validate("inline fragment on "+f.TypeCondition, "inline fragment", f.Position, f.TypeCondition)
+		}
+	})
+}
+
+// validateFragmentsMustBeUsed validates that every fragment definition is spread by an operation,
+// directly or through other fragments.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Fragments-Must-Be-Used
+func (v *validator) validateFragmentsMustBeUsed() {
+	used := make(map[string]bool, len(v.document.FragmentDefinitions))
+	var use func(set ast.SelectionSet)
+	use = func(set ast.SelectionSet) {
+		for _, spread := range fragmentSpreads(set) {
+			if used[spread.FragmentName] {
+				continue
+			}
+			used[spread.FragmentName] = true
+			if fd := v.fragment(spread.FragmentName); fd != nil {
+				use(fd.SelectionSet)
+			}
+		}
+	}
+	for _, op := range v.document.OperationDefinitions {
+		use(op.SelectionSet)
+	}
+
+	for _, fd := range v.document.FragmentDefinitions {
+		if !used[fd.Name] {
+			v.report(fd.Name, fd.Position, "fragment %s is never used", fd.Name)
+		}
+	}
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-Fragment-spread-target-defined
+func (v *validator) validateFragmentSpreadTargetDefined() {
+	v.eachSelection(func(sel ast.Selection) {
+		if spread, ok := sel.(*ast.FragmentSpread); ok && v.fragment(spread.FragmentName) == nil {
+			v.report(spread.FragmentName, spread.Position, "undefined fragment %s", spread.FragmentName)
+		}
+	})
+}
+
+// validateFragmentSpreadsMustNotFormCycles reports every cycle of fragment spreads once,
+// with the path of fragments which forms it, e.g. "A -> B -> A".
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Fragment-spreads-must-not-form-cycles
+func (v *validator) validateFragmentSpreadsMustNotFormCycles() {
+	var (
+		visited = make(map[string]bool, len(v.document.FragmentDefinitions))
+		// path holds the names of the fragments being searched, from the fragment the search started at.
+		path  []string
+		index = make(map[string]int)
+	)
+
+	var search func(fd *ast.FragmentDefinition)
+	search = func(fd *ast.FragmentDefinition) {
+		visited[fd.Name] = true
+		index[fd.Name] = len(path)
+		path = append(path, fd.Name)
+
+		for _, spread := range fragmentSpreads(fd.SelectionSet) {
+			if i, ok := index[spread.FragmentName]; ok {
+				cycle := append(slices.Clone(path[i:]), spread.FragmentName)
+				v.report(path[i], spread.Position, "fragment %s must not spread itself: %s", path[i], strings.Join(cycle, " -> "))
+				continue
+			}
+			if visited[spread.FragmentName] {
+				continue
+			}
+			if target := v.fragment(spread.FragmentName); target != nil {
+				search(target)
+			}
+		}
+
+		path = path[:len(path)-1]
+		delete(index, fd.Name)
+	}
+
+	for _, fd := range v.document.FragmentDefinitions {
+		if !visited[fd.Name] {
+			search(fd)
+		}
+	}
+}
+
+// validateFragmentSpreadIsPossible validates that every fragment can apply to some object type its parent type can be.
+// The possible types are the member types of a union and the object types declaring that they implement an interface.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Fragment-spread-is-possible
+func (v *validator) validateFragmentSpreadIsPossible() {
+	v.walkSelectionSets(func(parent ast.TypeDefinition, set ast.SelectionSet) {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.FragmentSpread:
+				fd := v.fragment(sel.FragmentName)
+				if fd == nil {
+					continue
+				}
+				if td := v.compositeType(fd.TypeCondition); td != nil && !v.isPossibleSpread(td, parent) {
+					v.report(sel.FragmentName, sel.Position, "fragment %s on %s can never be spread on %s", fd.Name, td.TypeName(), parent.TypeName())
+				}
+			case *ast.InlineFragment:
+				if sel.TypeCondition == "" {
+					continue
+				}
+				if td := v.compositeType(sel.TypeCondition); td != nil && !v.isPossibleSpread(td, parent) {
+					v.report("inline fragment on "+td.TypeName(), sel.Position, "inline fragment on %s can never be spread on %s", td.TypeName(), parent.TypeName())
+				}
+			}
+		}
+	})
+}
+
+// isPossibleSpread reports whether an object type is possible for both fragment and parent.
+func (v *validator) isPossibleSpread(fragment, parent ast.TypeDefinition) bool {
+	possible := make(map[string]bool)
+	for _, obj := range v.schema.PossibleTypes(parent) {
+		possible[obj.Name] = true
+	}
+	for _, obj := range v.schema.PossibleTypes(fragment) {
+		if possible[obj.Name] {
+			return true
+		}
+	}
+	return false
+}
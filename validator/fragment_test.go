@@ -0,0 +1,110 @@
+package validator
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+const fragmentTestSchema = `
+type Query { pet: Pet dog: Dog cat: Cat catOrDog: CatOrDog human: Human }
+interface Pet { name: String! }
+interface Being { name: String! }
+type Dog implements Pet & Being { name: String! barks: Boolean! }
+type Cat implements Pet & Being { name: String! meows: Boolean! }
+type Human implements Being { name: String! }
+union CatOrDog = Cat | Dog
+union DogOrHuman = Dog | Human
+enum Color { RED }
+`
+
+func Test_validateFragments(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name: "valid fragments",
+			query: `{ pet { ...PetFields ... on Dog { barks } } catOrDog { ...DogFields ... on Being { name } } }
+fragment PetFields on Pet { name ...BeingFields }
+fragment BeingFields on Being { name }
+fragment DogFields on Dog { barks ... { name } }`,
+		},
+		{
+			name:  "fragment names must be unique",
+			query: `{ dog { ...F } } fragment F on Dog { name } fragment F on Dog { barks }`,
+			want:  []string{"fragment F must be defined only once"},
+		},
+		{
+			name:  "type conditions must name defined types",
+			query: `{ dog { ...F ... on Bird { name } } } fragment F on Bird { name }`,
+			want: []string{
+				"undefined type Bird in type condition of fragment F",
+				"undefined type Bird in type condition of inline fragment",
+			},
+		},
+		{
+			name:  "type conditions must name composite types",
+			query: `{ dog { ...F ... on Color { name } } } fragment F on String { length }`,
+			want: []string{
+				"type condition of fragment F must be object, interface or union type, String is scalar type",
+				"type condition of inline fragment must be object, interface or union type, Color is enum type",
+			},
+		},
+		{
+			name: "fragments must be used",
+			query: `{ dog { ...Used } }
+fragment Used on Dog { ...UsedIndirectly }
+fragment UsedIndirectly on Dog { name }
+fragment Unused on Dog { ...UnusedIndirectly }
+fragment UnusedIndirectly on Dog { name }`,
+			want: []string{
+				"fragment Unused is never used",
+				"fragment UnusedIndirectly is never used",
+			},
+		},
+		{
+			name:  "spread targets must be defined",
+			query: `{ dog { ...Undefined ... on Dog { ...AlsoUndefined } } }`,
+			want: []string{
+				"undefined fragment Undefined",
+				"undefined fragment AlsoUndefined",
+			},
+		},
+		{
+			name: "fragment spreads must not form cycles",
+			query: `{ dog { ...A } }
+fragment A on Dog { ...B }
+fragment B on Dog { name ... on Dog { ...C } ...A }
+fragment C on Dog { ...C }`,
+			want: []string{
+				"fragment C must not spread itself: C -> C",
+				"fragment A must not spread itself: A -> B -> A",
+			},
+		},
+		{
+			name:  "spreads must be possible on the parent type",
+			query: `{ dog { ...CatFields ... on Cat { meows } } human { ...PetFields } catOrDog { ... on DogOrHuman { __typename } } } fragment CatFields on Cat { meows } fragment PetFields on Pet { name }`,
+			want: []string{
+				"fragment CatFields on Cat can never be spread on Dog",
+				"inline fragment on Cat can never be spread on Dog",
+				"fragment PetFields on Pet can never be spread on Human",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateExecutable(t, fragmentTestSchema, tt.query,
+				(*validator).validateFragmentNameUniqueness,
+				(*validator).validateFragmentTypeConditions,
+				(*validator).validateFragmentsMustBeUsed,
+				(*validator).validateFragmentSpreadTargetDefined,
+				(*validator).validateFragmentSpreadsMustNotFormCycles,
+				(*validator).validateFragmentSpreadIsPossible,
+			)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validate fragments mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
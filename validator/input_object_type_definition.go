@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"strings"
+)
+
+func (v *validator) validateInputObjectTypes() {
+	for _, td := range v.schema.Document().TypeDefinitions {
+		if td, ok := td.(*ast.InputObjectTypeDefinition); ok {
+			v.validateInputObjectTypeDefinition(td)
+		}
+	}
+}
+
+// https://spec.graphql.org/October2021/#sec-Input-Objects.Type-Validation
+func (v *validator) validateInputObjectTypeDefinition(td *ast.InputObjectTypeDefinition) {
+	if len(td.InputFields) == 0 {
+		v.report(td.Name, td.Position, "input object %s must define one or more input fields", td.Name)
+		return
+	}
+
+	names := make(map[string]bool, len(td.InputFields))
+	for _, f := range td.InputFields {
+		element := td.Name + "." + f.Name
+		if names[f.Name] {
+			v.report(element, f.Position, "input field name must be unique: %s", element)
+		}
+		names[f.Name] = true
+
+		if strings.HasPrefix(f.Name, "__") {
+			v.report(element, f.Position, "input field name must not begins with \"__\": %s", element)
+		}
+
+		if inputType, err := v.isInputType(f.Type); err != nil {
+			v.report(element, f.Position, "%s", err)
+		} else if !inputType {
+			v.report(element, f.Position, "input field %s must be input type (scalar, enum, input object)", element)
+		}
+	}
+}
+
+// validateInputObjectCycles rejects input objects which can not be given a finite value
+// because they reference themselves through a chain of non-null fields.
+// A nullable or list field anywhere in the chain breaks it. Every cycle is reported once, with the path of fields
+// which forms it, e.g. "A.b -> B.a".
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Input-Objects.Type-Validation
+func (v *validator) validateInputObjectCycles() {
+	// done holds input objects whose references have all been searched.
+	done := make(map[string]bool)
+	// path holds the fields followed from the input object where the search started.
+	var path []string
+	// onPath maps an input object on the path to the index in path of its first field.
+	onPath := make(map[string]int)
+
+	var visit func(td *ast.InputObjectTypeDefinition)
+	visit = func(td *ast.InputObjectTypeDefinition) {
+		onPath[td.Name] = len(path)
+
+		for _, f := range td.InputFields {
+			if !f.Type.NotNull || f.Type.ListType != nil {
+				continue
+			}
+			ref, ok := v.schema.Type(f.Type.NamedType).(*ast.InputObjectTypeDefinition)
+			if !ok {
+				continue
+			}
+
+			path = append(path, td.Name+"."+f.Name)
+			if i, ok := onPath[ref.Name]; ok {
+				v.report(ref.Name, ref.Position, "input object %s must not reference itself through non-null fields: %s", ref.Name, strings.Join(path[i:], " -> "))
+			} else if !done[ref.Name] {
+				visit(ref)
+			}
+			path = path[:len(path)-1]
+		}
+
+		delete(onPath, td.Name)
+		done[td.Name] = true
+	}
+
+	for _, td := range v.schema.Document().TypeDefinitions {
+		if io, ok := td.(*ast.InputObjectTypeDefinition); ok && !done[io.Name] {
+			visit(io)
+		}
+	}
+}
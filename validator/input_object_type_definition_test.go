@@ -0,0 +1,115 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func Test_validateInputObjectTypeDefinition(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		doc     *ast.TypeSystemExtensionDocument
+		wantErr bool
+	}{
+		{
+			name: "valid input objects",
+			schema: `
+input Filter { role: Role and: [Filter!] or: Filter not: Not! }
+input Not { filter: Filter }
+enum Role { ADMIN }
+`,
+		},
+		{
+			name: "The input object must define one or more input fields",
+			doc: &ast.TypeSystemExtensionDocument{
+				TypeDefinitions: []ast.TypeDefinition{
+					&ast.InputObjectTypeDefinition{Name: "Filter"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "The input fields must have unique names",
+			schema:  `input Filter { a: Int a: Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "The input field must not have a name which begins with \"__\"",
+			schema:  `input Filter { __a: Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "The input field must accept an input type",
+			schema:  `input Filter { user: User } type User { id: ID }`,
+			wantErr: true,
+		},
+		{
+			name:    "The input object must not reference itself through a non-null field",
+			schema:  `input Filter { self: Filter! }`,
+			wantErr: true,
+		},
+		{
+			name:    "The input objects must not reference each other through non-null fields",
+			schema:  `input A { b: B! } input B { c: C! name: String } input C { a: A! }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := tt.doc
+			if doc == nil {
+				doc = mustParse(t, tt.schema)
+			}
+			errs, err := validateSchema(doc, (*validator).validateInputObjectTypes, (*validator).validateInputObjectCycles)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateInputObjectCycles() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateInputObjectCycles(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   []string
+	}{
+		{
+			name:   "A nullable or list field breaks the cycle",
+			schema: `input A { b: B! } input B { a: A c: [A!]! }`,
+		},
+		{
+			name:   "The input object must not reference itself through non-null fields",
+			schema: `input A { b: B! } input B { c: C! } input C { a: A! }`,
+			want:   []string{"input object A must not reference itself through non-null fields: A.b -> B.c -> C.a"},
+		},
+		{
+			name:   "Every cycle through an input object is reported",
+			schema: `input A { b: B! c: C! } input B { a: A! } input C { a: A! }`,
+			want: []string{
+				"input object A must not reference itself through non-null fields: A.b -> B.a",
+				"input object A must not reference itself through non-null fields: A.c -> C.a",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := validateSchema(mustParse(t, tt.schema), (*validator).validateInputObjectCycles)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Message)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validateInputObjectCycles() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+func (v *validator) validateInterfaceTypes() {
+	for _, td := range v.schema.Document().TypeDefinitions {
+		if td, ok := td.(*ast.InterfaceTypeDefinition); ok {
+			v.validateInterfaceTypeDefinition(td)
+		}
+	}
+}
+
+// https://spec.graphql.org/October2021/#sec-Interfaces.Type-Validation
+func (v *validator) validateInterfaceTypeDefinition(td *ast.InterfaceTypeDefinition) {
+	v.validateFieldsDefinition(td)
+	v.validateImplementsInterfaces(td)
+}
@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"testing"
+)
+
+func Test_validateInterfaceTypeDefinition(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		doc     *ast.TypeSystemExtensionDocument
+		wantErr bool
+	}{
+		{
+			name: "valid implementations",
+			schema: `
+interface Node { id: ID! }
+interface Entity implements Node { id: ID! owner(first: Int): Entity related: [SearchResult] }
+type User implements Entity & Node {
+  id: ID!
+  owner(first: Int, extra: Boolean, required: Int! = 1): User!
+  related: [User!]!
+}
+union SearchResult = User
+`,
+		},
+		{
+			name: "The interface must define one or more fields",
+			doc: &ast.TypeSystemExtensionDocument{
+				TypeDefinitions: []ast.TypeDefinition{
+					&ast.InterfaceTypeDefinition{Name: "Node"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "The interface must not implement itself",
+			schema:  `interface Node implements Node { id: ID }`,
+			wantErr: true,
+		},
+		{
+			name:    "The object must define every field of the interface",
+			schema:  `interface Node { id: ID } type User implements Node { name: String }`,
+			wantErr: true,
+		},
+		{
+			name:    "The object must define every argument of the interface field",
+			schema:  `interface Node { id(x: Int): ID } type User implements Node { id: ID }`,
+			wantErr: true,
+		},
+		{
+			name:    "The argument must have the same type as in the interface",
+			schema:  `interface Node { id(x: Int): ID } type User implements Node { id(x: Int!): ID }`,
+			wantErr: true,
+		},
+		{
+			name:    "An additional argument must not be required",
+			schema:  `interface Node { id: ID } type User implements Node { id(x: Int!): ID }`,
+			wantErr: true,
+		},
+		{
+			name:    "The field must not return a nullable type for a non-null type",
+			schema:  `interface Node { id: ID! } type User implements Node { id: ID }`,
+			wantErr: true,
+		},
+		{
+			name:    "The field must not return a list for a named type",
+			schema:  `interface Node { id: ID } type User implements Node { id: [ID] }`,
+			wantErr: true,
+		},
+		{
+			name:    "The field must return a sub-type of the interface field type",
+			schema:  `interface Node { self: Node } type User implements Node { self: Group } type Group { id: ID }`,
+			wantErr: true,
+		},
+		{
+			name:    "The field must return a member of the union",
+			schema:  `interface Node { self: Result } union Result = Group type User implements Node { self: User } type Group { id: ID }`,
+			wantErr: true,
+		},
+		{
+			name:    "The object must implement the interfaces that its interfaces implement",
+			schema:  `interface Node { id: ID } interface Entity implements Node { id: ID } type User implements Entity { id: ID }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := tt.doc
+			if doc == nil {
+				doc = mustParse(t, tt.schema)
+			}
+			errs, err := validateSchema(doc, (*validator).validateObjectTypes, (*validator).validateInterfaceTypes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateInterfaceTypes() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
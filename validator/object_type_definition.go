@@ -0,0 +1,19 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+func (v *validator) validateObjectTypes() {
+	for _, td := range v.schema.Document().TypeDefinitions {
+		if td, ok := td.(*ast.ObjectTypeDefinition); ok {
+			v.validateObjectTypeDefinition(td)
+		}
+	}
+}
+
+// https://spec.graphql.org/October2021/#sec-Objects.Type-Validation
+func (v *validator) validateObjectTypeDefinition(td *ast.ObjectTypeDefinition) {
+	v.validateFieldsDefinition(td)
+	v.validateImplementsInterfaces(td)
+}
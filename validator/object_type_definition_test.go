@@ -0,0 +1,108 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/parser"
+	"testing"
+)
+
+func mustParse(t *testing.T, schema string) *ast.TypeSystemExtensionDocument {
+	t.Helper()
+	doc, err := parser.ParseTypeSystemExtensionDocument(&ast.Source{Name: "schema.graphql", Body: schema})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func Test_validateObjectTypeDefinition(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		doc     *ast.TypeSystemExtensionDocument
+		wantErr bool
+	}{
+		{
+			name: "valid object",
+			schema: `
+type Query { user(id: ID!, filter: Filter): User }
+type User { id: ID! friends(first: Int = 10): [User!]! }
+input Filter { name: String }
+`,
+		},
+		{
+			name: "The object must define one or more fields",
+			doc: &ast.TypeSystemExtensionDocument{
+				TypeDefinitions: []ast.TypeDefinition{
+					&ast.ObjectTypeDefinition{Name: "Empty"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "The field must have a unique name within the object",
+			schema:  `type Query { a: Int a: String }`,
+			wantErr: true,
+		},
+		{
+			name:    "The field must not have a name which begins with \"__\"",
+			schema:  `type Query { __a: Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "The field must return an output type",
+			schema:  `type Query { a: [Filter] } input Filter { a: Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "The field must return a defined type",
+			schema:  `type Query { a: Undefined }`,
+			wantErr: true,
+		},
+		{
+			name:    "The argument must have a unique name",
+			schema:  `type Query { a(x: Int, x: Int): Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "The argument must not have a name which begins with \"__\"",
+			schema:  `type Query { a(__x: Int): Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "The argument must accept an input type",
+			schema:  `type Query { a(x: Query): Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "The type must not have a name which begins with \"__\"",
+			schema:  `type __Query { a: Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "The object must implement only interfaces",
+			schema:  `type Query implements User { a: Int } type User { a: Int }`,
+			wantErr: true,
+		},
+		{
+			name:    "The object must not implement an interface twice",
+			schema:  `type Query implements Node & Node { a: Int } interface Node { a: Int }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := tt.doc
+			if doc == nil {
+				doc = mustParse(t, tt.schema)
+			}
+			errs, err := validateSchema(doc, (*validator).validateTypeNames, (*validator).validateObjectTypes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateObjectTypes() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
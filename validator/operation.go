@@ -0,0 +1,84 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"strings"
+)
+
+// operationElement names op in an Error, e.g. "GetUser", or its operation type if it is anonymous.
+func operationElement(op *ast.OperationDefinition) string {
+	if op.Name == "" {
+		return op.OperationType.String()
+	}
+	return op.Name
+}
+
+// describeOperation describes op in a message, e.g. "query GetUser" or "anonymous query".
+func describeOperation(op *ast.OperationDefinition) string {
+	if op.Name == "" {
+		return "anonymous " + op.OperationType.String()
+	}
+	return op.OperationType.String() + " " + op.Name
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-Operation-Name-Uniqueness
+func (v *validator) validateOperationNameUniqueness() {
+	defined := make(map[string]bool, len(v.document.OperationDefinitions))
+	for _, op := range v.document.OperationDefinitions {
+		if op.Name == "" {
+			continue
+		}
+		if defined[op.Name] {
+			v.report(op.Name, op.Position, "operation %s must be defined only once", op.Name)
+			continue
+		}
+		defined[op.Name] = true
+	}
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-Lone-Anonymous-Operation
+func (v *validator) validateLoneAnonymousOperation() {
+	if len(v.document.OperationDefinitions) < 2 {
+		return
+	}
+	for _, op := range v.document.OperationDefinitions {
+		if op.Name == "" {
+			v.report(operationElement(op), op.Position, "%s must be the only operation in the document", describeOperation(op))
+		}
+	}
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-Single-root-field
+func (v *validator) validateSubscriptionSingleRootField() {
+	root := v.schema.SubscriptionType()
+	if root == nil {
+		return
+	}
+
+	for _, op := range v.document.OperationDefinitions {
+		if op.OperationType != ast.OperationTypeSubscription {
+			continue
+		}
+
+		var (
+			keys  []string
+			first = make(map[string]*ast.Field)
+		)
+		for _, f := range v.collectFields(root, op.SelectionSet, make(map[string]bool)) {
+			if _, ok := first[f.ResponseKey()]; ok {
+				continue
+			}
+			first[f.ResponseKey()] = f
+			keys = append(keys, f.ResponseKey())
+		}
+
+		if len(keys) > 1 {
+			v.report(operationElement(op), first[keys[1]].Position, "%s must select only one root field, it selects %s", describeOperation(op), strings.Join(keys, ", "))
+		}
+		for _, key := range keys {
+			if f := first[key]; strings.HasPrefix(f.Name, "__") {
+				v.report(operationElement(op), f.Position, "%s must not select introspection field %s as root field", describeOperation(op), f.Name)
+			}
+		}
+	}
+}
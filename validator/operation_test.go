@@ -0,0 +1,79 @@
+package validator
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+const operationTestSchema = `
+type Query { a: Int b: Int }
+type Mutation { c: Int }
+type Subscription { d: Int e: Int }
+`
+
+func Test_validateOperations(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "operation names must be unique across operation types",
+			query: `query A { a } mutation A { c } query B { b }`,
+			want:  []string{"operation A must be defined only once"},
+		},
+		{
+			name:  "an anonymous operation must be alone",
+			query: `{ a } query B { b }`,
+			want:  []string{"anonymous query must be the only operation in the document"},
+		},
+		{
+			name:  "a lone anonymous operation",
+			query: `{ a } fragment F on Query { b }`,
+		},
+		{
+			name:  "a subscription must select one root field",
+			query: `subscription S { d e }`,
+			want:  []string{"subscription S must select only one root field, it selects d, e"},
+		},
+		{
+			name:  "fields of the same response key count as one",
+			query: `subscription S { d ... on Subscription { d } }`,
+		},
+		{
+			name:  "root fields are collected from fragments",
+			query: `subscription S { ...F } fragment F on Subscription { d x: e }`,
+			want:  []string{"subscription S must select only one root field, it selects d, x"},
+		},
+		{
+			name:  "root fields skipped with a literal are not collected",
+			query: `subscription S { d e @skip(if: true) f: e @include(if: false) }`,
+		},
+		{
+			name:  "root fields skipped with a variable are collected",
+			query: `subscription S($v: Boolean!) { d e @skip(if: $v) }`,
+			want:  []string{"subscription S must select only one root field, it selects d, e"},
+		},
+		{
+			name:  "a subscription must not select an introspection root field",
+			query: `subscription { __typename }`,
+			want:  []string{"anonymous subscription must not select introspection field __typename as root field"},
+		},
+		{
+			name:  "fragment cycles end the collection",
+			query: `subscription S { ...F } fragment F on Subscription { d ...F }`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateExecutable(t, operationTestSchema, tt.query,
+				(*validator).validateOperationNameUniqueness,
+				(*validator).validateLoneAnonymousOperation,
+				(*validator).validateSubscriptionSingleRootField,
+			)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validate operations mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
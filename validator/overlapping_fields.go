@@ -0,0 +1,422 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/printer"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// validateOverlappingFieldsCanBeMerged validates that the fields of every operation and fragment which share a response key
+// can be merged into one field of the response, following fragment spreads and inline fragments.
+//
+// Comparing every pair of fields as FieldsInSetCanMerge of the specification describes goes quadratic in the fields of
+// a response key and exponential in nested fragments. Instead, the fields of a response key are grouped and each field
+// is compared with the first one of its group, which is enough as having the same response shape and having the same
+// name and arguments are both equivalence relations. The subselections of a group are merged and checked in turn.
+// Fragments are expanded once per merged selection set, from the fields and spreads of each fragment which are
+// collected once. A set of fields is compared once, however many times fragments select it, so that fragments spread
+// under several response keys do not go exponential and fragments spreading themselves in a subselection end the search.
+// The work still grows with the depth of the response times the fields merged on each level.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Field-Selection-Merging
+func (v *validator) validateOverlappingFieldsCanBeMerged() {
+	m := &fieldMerger{
+		validator:          v,
+		expanded:           make(map[string]bool),
+		reported:           make(map[[2]*ast.Field]bool),
+		fragmentSelections: make(map[string][]selection),
+		fieldSelections:    make(map[*ast.Field][]selection),
+		compared:           make(map[string]bool),
+		ids:                make(map[*ast.Field]int),
+	}
+
+	for _, op := range v.document.OperationDefinitions {
+		if root := v.rootOperationType(op); root != nil {
+			m.check(nil, m.collect([][]selection{m.selections(root, op.SelectionSet)}))
+		}
+	}
+	// the fields of a fragment spread by an operation are already checked as part of it.
+	for _, fd := range v.document.FragmentDefinitions {
+		if td := v.compositeType(fd.TypeCondition); td != nil && !m.expanded[fd.Name] {
+			m.check(nil, m.collect([][]selection{m.selections(td, fd.SelectionSet)}))
+		}
+	}
+}
+
+// selectedField is a field with the type it is selected on and its definition, which is nil if it is unknown.
+type selectedField struct {
+	parent     ast.TypeDefinition
+	field      *ast.Field
+	definition *ast.FieldDefinition
+}
+
+// selection is a field of a selection set, including the fields of its inline fragments, or the name of a fragment it spreads.
+type selection struct {
+	field  selectedField
+	spread string
+}
+
+// responsePath is the response keys from an operation or a fragment definition to fields, nil for none.
+// The path is shared with the paths of the parent fields, as it grows with the depth of the response.
+type responsePath struct {
+	parent *responsePath
+	key    string
+}
+
+func (p *responsePath) child(key string) *responsePath {
+	return &responsePath{parent: p, key: key}
+}
+
+func (p *responsePath) String() string {
+	var keys []string
+	for ; p != nil; p = p.parent {
+		keys = append(keys, p.key)
+	}
+	slices.Reverse(keys)
+	return strings.Join(keys, ".")
+}
+
+// fieldGroups holds fields by response key, in the order the keys are first selected.
+type fieldGroups struct {
+	keys   []string
+	fields map[string][]selectedField
+}
+
+type fieldMerger struct {
+	*validator
+
+	// expanded holds the names of the fragments whose fields have been collected.
+	expanded map[string]bool
+	// reported holds the pairs of fields already reported, as a conflict is found again from every operation
+	// and fragment which selects it. A pair is reported once, for the first reason found.
+	reported map[[2]*ast.Field]bool
+
+	// fragmentSelections and fieldSelections cache the selections of fragment definitions, whose parent type is
+	// their type condition, and of the subselections of fields, whose parent type is the type of the field.
+	fragmentSelections map[string][]selection
+	fieldSelections    map[*ast.Field][]selection
+	// compared holds the sets of fields already compared, see compareOnce.
+	compared map[string]bool
+	// ids numbers the fields to key compared.
+	ids map[*ast.Field]int
+}
+
+// The ways a set of fields is compared, which make up the keys of compared along with the fields.
+const (
+	compareAll           = 'a'
+	compareShape         = 's'
+	compareCommonParents = 'p'
+)
+
+// compareOnce reports whether fields have not been compared the way mode tells yet, and records that they are.
+// Comparing the same fields again would find the same conflicts, so it is skipped. This also ends the search
+// when a fragment spreads itself in a subselection, as the same fields are then selected again.
+func (m *fieldMerger) compareOnce(mode byte, fields []selectedField) bool {
+	ids := make([]int, len(fields))
+	for i, f := range fields {
+		id, ok := m.ids[f.field]
+		if !ok {
+			id = len(m.ids)
+			m.ids[f.field] = id
+		}
+		ids[i] = id
+	}
+	slices.Sort(ids)
+
+	key := []byte{mode}
+	for _, id := range ids {
+		key = strconv.AppendInt(append(key, ' '), int64(id), 10)
+	}
+	if m.compared[string(key)] {
+		return false
+	}
+	m.compared[string(key)] = true
+	return true
+}
+
+func (m *fieldMerger) check(path *responsePath, groups fieldGroups) {
+	for _, key := range groups.keys {
+		m.checkFields(path.child(key), groups.fields[key])
+	}
+}
+
+// checkFields validates that fields, which share a response key, have the same response shape and that they have the same
+// name and arguments if they can be selected on the same object. As long as all of them can be, both are validated
+// in one pass over the merged subselections.
+func (m *fieldMerger) checkFields(path *responsePath, fields []selectedField) {
+	if !m.compareOnce(compareAll, fields) {
+		return
+	}
+
+	sameShape := m.compareShapes(path, fields)
+	groups := groupByCommonParents(fields)
+	for _, group := range groups {
+		m.compareNamesAndArguments(path, group)
+	}
+
+	if len(groups) == 1 && sameShape {
+		sub := m.collect(m.subSelections(fields))
+		for _, key := range sub.keys {
+			m.checkFields(path.child(key), sub.fields[key])
+		}
+		return
+	}
+
+	if sameShape {
+		m.sameResponseShapeOfSubfields(path, fields)
+	}
+	for _, group := range groups {
+		m.sameForCommonParentsOfSubfields(path, group)
+	}
+}
+
+// sameResponseShape validates that fields, which share a response key, have the same response shape,
+// whatever types they are selected on.
+//
+// Reference: https://spec.graphql.org/October2021/#SameResponseShape()
+func (m *fieldMerger) sameResponseShape(path *responsePath, fields []selectedField) {
+	if !m.compareOnce(compareShape, fields) {
+		return
+	}
+	if m.compareShapes(path, fields) {
+		m.sameResponseShapeOfSubfields(path, fields)
+	}
+}
+
+func (m *fieldMerger) sameResponseShapeOfSubfields(path *responsePath, fields []selectedField) {
+	sub := m.collect(m.subSelections(fields))
+	for _, key := range sub.keys {
+		m.sameResponseShape(path.child(key), sub.fields[key])
+	}
+}
+
+// compareShapes compares the type of every field with the first one and reports the first conflict.
+// It reports whether there is none.
+func (m *fieldMerger) compareShapes(path *responsePath, fields []selectedField) bool {
+	var first *selectedField
+	for i, f := range fields {
+		if f.definition == nil {
+			continue
+		}
+		if first == nil {
+			first = &fields[i]
+			continue
+		}
+		if m.doTypesConflict(first.definition.Type, f.definition.Type) {
+			m.reportConflict(path, *first, f, "they return conflicting types %s and %s", typeString(first.definition.Type), typeString(f.definition.Type))
+			return false
+		}
+	}
+	return true
+}
+
+// sameForCommonParents validates that fields, which share a response key, have the same name and arguments
+// if they can be selected on the same object, that is if their parent types are equal or either is not an object type.
+//
+// Reference: https://spec.graphql.org/October2021/#FieldsInSetCanMerge()
+func (m *fieldMerger) sameForCommonParents(path *responsePath, fields []selectedField) {
+	if !m.compareOnce(compareCommonParents, fields) {
+		return
+	}
+	for _, group := range groupByCommonParents(fields) {
+		m.compareNamesAndArguments(path, group)
+		m.sameForCommonParentsOfSubfields(path, group)
+	}
+}
+
+func (m *fieldMerger) sameForCommonParentsOfSubfields(path *responsePath, group []selectedField) {
+	sub := m.collect(m.subSelections(group))
+	for _, key := range sub.keys {
+		m.sameForCommonParents(path.child(key), sub.fields[key])
+	}
+}
+
+// compareNamesAndArguments compares the name and arguments of every field of group with the first one.
+func (m *fieldMerger) compareNamesAndArguments(path *responsePath, group []selectedField) {
+	first := group[0]
+	for _, f := range group[1:] {
+		if f.field.Name != first.field.Name {
+			m.reportConflict(path, first, f, "%s and %s are different fields", first.field.Name, f.field.Name)
+		} else if !sameArguments(first.field.Arguments, f.field.Arguments) {
+			m.reportConflict(path, first, f, "they have differing arguments")
+		}
+	}
+}
+
+// groupByCommonParents groups fields by their object parent type, keeping the order of fields.
+// The fields of other parent types can be selected along with any field, so they are in every group.
+func groupByCommonParents(fields []selectedField) [][]selectedField {
+	var names []string
+	for _, f := range fields {
+		if isObjectType(f.parent) && !slices.Contains(names, f.parent.TypeName()) {
+			names = append(names, f.parent.TypeName())
+		}
+	}
+	if len(names) == 0 {
+		return [][]selectedField{fields}
+	}
+
+	groups := make([][]selectedField, len(names))
+	for i, name := range names {
+		for _, f := range fields {
+			if !isObjectType(f.parent) || f.parent.TypeName() == name {
+				groups[i] = append(groups[i], f)
+			}
+		}
+	}
+	return groups
+}
+
+func (m *fieldMerger) reportConflict(path *responsePath, f1, f2 selectedField, format string, args ...any) {
+	pair := [2]*ast.Field{f1.field, f2.field}
+	if m.reported[pair] {
+		return
+	}
+	m.reported[pair] = true
+
+	element := path.String()
+	args = append([]any{element}, args...)
+	m.report(element, f2.field.Position, "fields %s conflict because "+format, args...)
+}
+
+// subSelections returns the selections of the subselections of fields.
+func (m *fieldMerger) subSelections(fields []selectedField) [][]selection {
+	var sets [][]selection
+	for _, f := range fields {
+		if f.definition == nil || len(f.field.SelectionSet) == 0 {
+			continue
+		}
+		sels, ok := m.fieldSelections[f.field]
+		if !ok {
+			if td := m.compositeType(getUnderlyingType(f.definition.Type).NamedType); td != nil {
+				sels = m.selections(td, f.field.SelectionSet)
+			}
+			m.fieldSelections[f.field] = sels
+		}
+		if sels != nil {
+			sets = append(sets, sels)
+		}
+	}
+	return sets
+}
+
+// collect groups the fields of sets by response key, following fragment spreads.
+// Each fragment is expanded once however many times it is spread, so a cycle of fragments ends the expansion,
+// and a field reached through several fragment spreads is collected once.
+func (m *fieldMerger) collect(sets [][]selection) fieldGroups {
+	groups := fieldGroups{fields: make(map[string][]selectedField)}
+	collected := make(map[*ast.Field]bool)
+	spread := make(map[string]bool)
+
+	var add func(sels []selection)
+	add = func(sels []selection) {
+		for _, sel := range sels {
+			if sel.spread == "" {
+				if collected[sel.field.field] {
+					continue
+				}
+				collected[sel.field.field] = true
+
+				key := sel.field.field.ResponseKey()
+				if _, ok := groups.fields[key]; !ok {
+					groups.keys = append(groups.keys, key)
+				}
+				groups.fields[key] = append(groups.fields[key], sel.field)
+				continue
+			}
+
+			if spread[sel.spread] {
+				continue
+			}
+			spread[sel.spread] = true
+			m.expanded[sel.spread] = true
+			add(m.spreadSelections(sel.spread))
+		}
+	}
+
+	for _, sels := range sets {
+		add(sels)
+	}
+	return groups
+}
+
+// spreadSelections returns the selections of the fragment named name, or nil if it is not defined.
+func (m *fieldMerger) spreadSelections(name string) []selection {
+	if sels, ok := m.fragmentSelections[name]; ok {
+		return sels
+	}
+	var sels []selection
+	if fd := m.fragment(name); fd != nil {
+		sels = m.selections(m.compositeType(fd.TypeCondition), fd.SelectionSet)
+	}
+	m.fragmentSelections[name] = sels
+	return sels
+}
+
+// selections returns the fields of set, which selects on parent, and of its inline fragments along with
+// the fragments it spreads, in the order they are selected.
+func (m *fieldMerger) selections(parent ast.TypeDefinition, set ast.SelectionSet) []selection {
+	var sels []selection
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			var def *ast.FieldDefinition
+			if parent != nil {
+				def = m.fieldDefinition(parent, sel.Name)
+			}
+			sels = append(sels, selection{field: selectedField{parent: parent, field: sel, definition: def}})
+		case *ast.FragmentSpread:
+			sels = append(sels, selection{spread: sel.FragmentName})
+		case *ast.InlineFragment:
+			td := parent
+			if sel.TypeCondition != "" {
+				td = m.compositeType(sel.TypeCondition)
+			}
+			sels = append(sels, m.selections(td, sel.SelectionSet)...)
+		}
+	}
+	return sels
+}
+
+// doTypesConflict reports whether fields of t1 and t2 can not have the same response shape.
+//
+// Reference: https://spec.graphql.org/October2021/#SameResponseShape()
+func (m *fieldMerger) doTypesConflict(t1, t2 ast.Type) bool {
+	if t1.NotNull != t2.NotNull {
+		return true
+	}
+	if t1.ListType != nil || t2.ListType != nil {
+		if t1.ListType == nil || t2.ListType == nil {
+			return true
+		}
+		return m.doTypesConflict(*t1.ListType, *t2.ListType)
+	}
+
+	if isLeafType(m.schema.Type(t1.NamedType)) || isLeafType(m.schema.Type(t2.NamedType)) {
+		return t1.NamedType != t2.NamedType
+	}
+	return false
+}
+
+func sameArguments(args1, args2 []ast.Argument) bool {
+	if len(args1) != len(args2) {
+		return false
+	}
+	for _, arg1 := range args1 {
+		arg2 := findArgumentValue(args2, arg1.Name)
+		if arg2 == nil || printer.FormatValue(arg1.Value) != printer.FormatValue(arg2.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func isObjectType(td ast.TypeDefinition) bool {
+	return td != nil && td.TypeDefinitionKind() == ast.TypeDefinitionKindObject
+}
+
+func isLeafType(td ast.TypeDefinition) bool {
+	return td != nil && (td.TypeDefinitionKind() == ast.TypeDefinitionKindScalar || td.TypeDefinitionKind() == ast.TypeDefinitionKindEnum)
+}
@@ -0,0 +1,187 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/parser"
+	"github.com/Sntree2mi8/gogqlparser/schema"
+	"github.com/google/go-cmp/cmp"
+	"strings"
+	"testing"
+)
+
+const overlappingFieldsTestSchema = `
+type Query { dog: Dog pet: Pet node: Node }
+enum DogCommand { SIT HEEL }
+interface Pet { name: String! }
+type Dog implements Pet { name: String! nickname: String color: String barkVolume: Int doesKnowCommand(dogCommand: DogCommand!): Boolean! owner: Human }
+type Cat implements Pet { name: String! meowVolume: Int }
+type Human { name: String id: ID! pets: [Pet!] }
+type Node { id: ID! name: String child: Node children: [Node!]! }
+`
+
+func Test_validateOverlappingFieldsCanBeMerged(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "identical fields",
+			query: `{ dog { name name otherName: name doesKnowCommand(dogCommand: SIT) doesKnowCommand(dogCommand: SIT) } }`,
+		},
+		{
+			name:  "different fields of a response key",
+			query: `{ dog { name: nickname name: color } }`,
+			want:  []string{"fields dog.name conflict because nickname and color are different fields"},
+		},
+		{
+			name:  "differing arguments",
+			query: `{ dog { doesKnowCommand(dogCommand: SIT) doesKnowCommand(dogCommand: HEEL) } }`,
+			want:  []string{"fields dog.doesKnowCommand conflict because they have differing arguments"},
+		},
+		{
+			name:  "missing argument",
+			query: `query($c: DogCommand!) { dog { doesKnowCommand(dogCommand: $c) doesKnowCommand } }`,
+			want:  []string{"fields dog.doesKnowCommand conflict because they have differing arguments"},
+		},
+		{
+			name:  "conflicts through fragments",
+			query: `{ dog { ...A ...B } } fragment A on Dog { x: name } fragment B on Dog { x: barkVolume }`,
+			want: []string{
+				"fields dog.x conflict because they return conflicting types String! and Int",
+			},
+		},
+		{
+			name:  "fields of different object types may differ",
+			query: `{ pet { ... on Dog { volume: barkVolume name } ... on Cat { volume: meowVolume name: meowVolume } } }`,
+			want:  []string{"fields pet.name conflict because they return conflicting types String! and Int"},
+		},
+		{
+			name:  "fields of an interface must not differ from fields of its implementations",
+			query: `{ pet { x: name ... on Dog { x: nickname } } }`,
+			want: []string{
+				"fields pet.x conflict because they return conflicting types String! and String",
+			},
+		},
+		{
+			name:  "conflicting subfields",
+			query: `{ dog { owner { x: name } owner { x: id } } }`,
+			want: []string{
+				"fields dog.owner.x conflict because they return conflicting types String and ID!",
+			},
+		},
+		{
+			name:  "list and non-list",
+			query: `{ node { ... on Node { x: child { id } } ... on Node { x: children { id } } } }`,
+			want: []string{
+				"fields node.x conflict because they return conflicting types Node and [Node!]!",
+			},
+		},
+		{
+			name:  "subfields of different object types are compared by shape only",
+			query: `{ pet { ... on Dog { owner { pets { name } } } ... on Cat { owner: name } } }`,
+			want:  []string{"fields pet.owner conflict because they return conflicting types Human and String!"},
+		},
+		{
+			name:  "conflicts inside a fragment are reported once",
+			query: `{ a: dog { ...F } b: dog { ...F } } fragment F on Dog { x: nickname x: barkVolume }`,
+			want:  []string{"fields a.x conflict because they return conflicting types String and Int"},
+		},
+		{
+			name: "fragment cycles end the search",
+			query: `{ dog { ...A } node { ...F } }
+fragment A on Dog { name ...B }
+fragment B on Dog { name ...A }
+fragment F on Node { child { ...F } }`,
+		},
+		{
+			name:  "conflicts of a fragment spread in its own subselection",
+			query: `{ node { ...F } } fragment F on Node { name child { ...F name: id } }`,
+			want:  []string{"fields node.child.name conflict because they return conflicting types String and ID!"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateExecutable(t, overlappingFieldsTestSchema, tt.query, (*validator).validateOverlappingFieldsCanBeMerged)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validateOverlappingFieldsCanBeMerged() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// nestedFragments returns a query of n fragments where each one spreads the previous one twice,
+// once directly and once in a subselection, so that expanding every spread gives 2^n fields.
+// The response is n levels deep with up to n fields of a response key on each, so validation grows with n^2.
+func nestedFragments(n int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "{ node { ...F%d } }\n", n-1)
+	b.WriteString("fragment F0 on Node { id name }\n")
+	for i := 1; i < n; i++ {
+		fmt.Fprintf(&b, "fragment F%d on Node { id child { ...F%d } ...F%d }\n", i, i-1, i-1)
+	}
+	return b.String()
+}
+
+// doublingFragments returns a query of n fragments where each one spreads the previous one under two response keys,
+// so that the response holds 2^n fields. Each fragment is compared once, so validation grows with n.
+func doublingFragments(n int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "{ node { ...F%d } }\n", n-1)
+	b.WriteString("fragment F0 on Node { id }\n")
+	for i := 1; i < n; i++ {
+		fmt.Fprintf(&b, "fragment F%d on Node { a: child { ...F%d } b: child { ...F%d } }\n", i, i-1, i-1)
+	}
+	return b.String()
+}
+
+// wideFragments returns a query spreading n fragments in one selection set, all of which select the same fields,
+// as generated Relay queries do. Validation grows with n.
+func wideFragments(n int) string {
+	var b strings.Builder
+	b.WriteString("{ node {")
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, " ...F%d", i)
+	}
+	b.WriteString(" } }\n")
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, "fragment F%d on Node { id name child { id name children { id } } }\n", i)
+	}
+	return b.String()
+}
+
+func BenchmarkValidateOverlappingFieldsCanBeMerged(b *testing.B) {
+	doc, err := parser.ParseTypeSystemExtensionDocument(&ast.Source{Body: overlappingFieldsTestSchema})
+	if err != nil {
+		b.Fatal(err)
+	}
+	s, err := schema.New(doc)
+	if err != nil {
+		b.Fatal(err)
+	}
+	rule := specifiedExecutableRule(RuleOverlappingFieldsCanBeMerged, (*validator).validateOverlappingFieldsCanBeMerged)
+
+	for _, bm := range []struct {
+		name  string
+		query func(n int) string
+	}{
+		{name: "nested", query: nestedFragments},
+		{name: "doubling", query: doublingFragments},
+		{name: "wide", query: wideFragments},
+	} {
+		for _, n := range []int{10, 100, 1000} {
+			query, err := parser.ParseExecutableDocument(&ast.Source{Body: bm.query(n)})
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.Run(fmt.Sprintf("%s/%d", bm.name, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					if err := ValidateExecutable(s, query, rule); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
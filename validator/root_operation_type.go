@@ -0,0 +1,94 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+// validateRootOperationTypes validates the root operation types of the schema definition.
+// Without a schema definition, the types named Query, Mutation and Subscription are the root operation types.
+// The schema must be defined once, and its extensions must not set a root operation type again.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Root-Operation-Types
+// Reference: https://spec.graphql.org/October2021/#sec-Schema-Extension
+func (v *validator) validateRootOperationTypes() {
+	doc := v.schema.Document()
+
+	for i, sd := range doc.SchemaDefinitions {
+		if i > 0 {
+			v.report("schema", sd.Position, "duplicate schema definition")
+		}
+	}
+	v.validateSchemaExtensions()
+
+	if len(doc.SchemaDefinitions) == 0 {
+		for _, name := range []string{"Query", "Mutation", "Subscription"} {
+			if td := v.schema.Type(name); td != nil && td.TypeDefinitionKind() != ast.TypeDefinitionKindObject {
+				v.report(name, td.GetPosition(), "root operation type %s must be object type, it is %s type", name, td.TypeDefinitionKind())
+			}
+		}
+		if v.schema.Type("Query") == nil {
+			v.report("schema", nil, "schema must define a query root operation type, there is neither schema definition nor type named Query")
+		}
+		return
+	}
+
+	sd := doc.SchemaDefinitions[0]
+	for _, root := range []struct {
+		operation string
+		def       *ast.RootOperationTypeDefinition
+	}{
+		{operation: "query", def: sd.Query},
+		{operation: "mutation", def: sd.Mutation},
+		{operation: "subscription", def: sd.Subscription},
+	} {
+		if root.def == nil {
+			if root.operation == "query" {
+				v.report("schema", sd.Position, "schema must define a query root operation type")
+			}
+			continue
+		}
+
+		td := v.schema.Type(root.def.Type)
+		if td == nil {
+			v.report("schema", root.def.Position, "undefined type: %s", root.def.Type)
+			continue
+		}
+		if td.TypeDefinitionKind() != ast.TypeDefinitionKindObject {
+			v.report("schema", root.def.Position, "%s root operation type %s must be object type, it is %s type", root.operation, root.def.Type, td.TypeDefinitionKind())
+		}
+	}
+}
+
+// validateSchemaExtensions validates that no schema extension sets a root operation type which the first schema definition
+// or a previous extension already sets. Extensions of an undefined schema are reported while building the schema.
+func (v *validator) validateSchemaExtensions() {
+	source := v.schema.Source()
+	if len(source.SchemaDefinitions) == 0 {
+		return
+	}
+
+	sd := source.SchemaDefinitions[0]
+	set := map[string]bool{
+		"query":        sd.Query != nil,
+		"mutation":     sd.Mutation != nil,
+		"subscription": sd.Subscription != nil,
+	}
+	for _, ext := range source.SchemaExtensions {
+		for _, root := range []struct {
+			operation string
+			def       *ast.RootOperationTypeDefinition
+		}{
+			{operation: "query", def: ext.Query},
+			{operation: "mutation", def: ext.Mutation},
+			{operation: "subscription", def: ext.Subscription},
+		} {
+			if root.def == nil {
+				continue
+			}
+			if set[root.operation] {
+				v.report("schema", ext.Position, "schema extension redefines %s root operation type", root.operation)
+			}
+			set[root.operation] = true
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package validator
+
+import (
+	"errors"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func Test_validateRootOperationTypes(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   []string
+	}{
+		{
+			name:   "valid schema definition",
+			schema: `schema { query: Root mutation: Mutation } type Root { a: Int } type Mutation { a: Int }`,
+		},
+		{
+			name:   "valid default root operation types",
+			schema: `type Query { a: Int } type Mutation { a: Int } type Subscription { a: Int }`,
+		},
+		{
+			name:   "The query root operation type must be defined",
+			schema: `schema { mutation: Mutation } type Mutation { a: Int }`,
+			want:   []string{"schema.graphql:1:1: schema must define a query root operation type"},
+		},
+		{
+			name:   "The type named Query must be defined without schema definition",
+			schema: `type Root { a: Int }`,
+			want:   []string{"schema must define a query root operation type, there is neither schema definition nor type named Query"},
+		},
+		{
+			name:   "The root operation types must be defined",
+			schema: `schema { query: Query subscription: Subscription } type Query { a: Int }`,
+			want:   []string{"schema.graphql:1:23: undefined type: Subscription"},
+		},
+		{
+			name:   "The root operation types must be object types",
+			schema: `schema { query: Query mutation: Mutation } type Query { a: Int } input Mutation { a: Int }`,
+			want:   []string{"schema.graphql:1:23: mutation root operation type Mutation must be object type, it is input object type"},
+		},
+		{
+			name:   "The default root operation types must be object types",
+			schema: `type Query { a: Int } union Mutation = Query`,
+			want:   []string{"schema.graphql:1:23: root operation type Mutation must be object type, it is union type"},
+		},
+		{
+			name:   "Only one schema definition must exist",
+			schema: `schema { query: Query } schema { query: Query } type Query { a: Int }`,
+			want:   []string{"schema.graphql:1:25: duplicate schema definition"},
+		},
+		{
+			name:   "The schema extensions must not redefine a root operation type",
+			schema: `schema { query: Query } extend schema { mutation: Query } extend schema { query: Query mutation: Query } type Query { a: Int }`,
+			want: []string{
+				"schema.graphql:1:59: schema extension redefines query root operation type",
+				"schema.graphql:1:59: schema extension redefines mutation root operation type",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := validateSchema(mustParse(t, tt.schema), (*validator).validateRootOperationTypes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validateRootOperationTypes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateTypeSystemExtensionDocument_SchemaDefinitions(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   []string
+	}{
+		{
+			name:   "Only one schema definition must exist",
+			schema: `schema { query: Query } schema { query: Query } type Query { a: Int }`,
+			want:   []string{"schema.graphql:1:25: duplicate schema definition"},
+		},
+		{
+			name:   "The schema extension must not redefine a root operation type",
+			schema: `schema { query: Query } extend schema { query: Query } type Query { a: Int }`,
+			want:   []string{"schema.graphql:1:25: schema extension redefines query root operation type"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs Errors
+			if err := ValidateTypeSystemExtensionDocument(mustParse(t, tt.schema)); !errors.As(err, &errs) {
+				t.Fatalf("ValidateTypeSystemExtensionDocument() error = %v, want Errors", err)
+			}
+			var got []string
+			for _, e := range errs {
+				if e.Rule != RuleRootOperationTypes {
+					t.Errorf("Rule = %s, want %s", e.Rule, RuleRootOperationTypes)
+				}
+				got = append(got, e.Error())
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ValidateTypeSystemExtensionDocument() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
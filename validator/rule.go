@@ -0,0 +1,124 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/schema"
+)
+
+// Rule is a validation rule which Validate runs over a schema.
+type Rule interface {
+	// Name identifies the rule. It is the Rule of every Error the rule reports.
+	Name() string
+	// Validate reports every violation of the rule in s with report.
+	Validate(s *schema.Schema, report ReportFunc)
+}
+
+// ReportFunc reports a violation by the schema element named element, e.g. "User.name", found at pos.
+// pos may be nil if the element has no position.
+type ReportFunc func(element string, pos *ast.Position, format string, args ...any)
+
+// NewRule returns a Rule named name which validates a schema with validate.
+func NewRule(name string, validate func(s *schema.Schema, report ReportFunc)) Rule {
+	return &funcRule{name: name, validate: validate}
+}
+
+type funcRule struct {
+	name     string
+	validate func(s *schema.Schema, report ReportFunc)
+}
+
+func (r *funcRule) Name() string {
+	return r.name
+}
+
+func (r *funcRule) Validate(s *schema.Schema, report ReportFunc) {
+	r.validate(s, report)
+}
+
+// SpecifiedRules returns the type system validation rules of the GraphQL specification, in the order they run
+// in ValidateTypeSystemExtensionDocument.
+func SpecifiedRules() []Rule {
+	return []Rule{
+		specifiedRule(RuleDirectiveDefinitions, (*validator).validateDirectiveDefinitions),
+		specifiedRule(RuleRootOperationTypes, (*validator).validateRootOperationTypes),
+		specifiedRule(RuleTypeNames, (*validator).validateTypeNames),
+		specifiedRule(RuleObjectTypes, (*validator).validateObjectTypes),
+		specifiedRule(RuleInterfaceTypes, (*validator).validateInterfaceTypes),
+		specifiedRule(RuleUnionTypes, (*validator).validateUnionTypes),
+		specifiedRule(RuleEnumTypes, (*validator).validateEnumTypes),
+		specifiedRule(RuleInputObjectTypes, (*validator).validateInputObjectTypes),
+		specifiedRule(RuleInputObjectCycles, (*validator).validateInputObjectCycles),
+		specifiedRule(RuleDirectiveUsages, (*validator).validateDirectiveUsages),
+		specifiedRule(RuleDefaultValues, (*validator).validateDefaultValues),
+	}
+}
+
+// specifiedRule returns a Rule which runs validate with a validator of the schema.
+func specifiedRule(name string, validate func(v *validator)) Rule {
+	return NewRule(name, func(s *schema.Schema, report ReportFunc) {
+		validate(&validator{schema: s, report: report})
+	})
+}
+
+// ExecutableRule is a validation rule which ValidateExecutable runs over an executable document.
+type ExecutableRule interface {
+	// Name identifies the rule. It is the Rule of every Error the rule reports.
+	Name() string
+	// Validate reports every violation of the rule in doc, which is run against s, with report.
+	Validate(s *schema.Schema, doc *ast.ExecutableDocument, report ReportFunc)
+}
+
+// NewExecutableRule returns an ExecutableRule named name which validates an executable document with validate.
+func NewExecutableRule(name string, validate func(s *schema.Schema, doc *ast.ExecutableDocument, report ReportFunc)) ExecutableRule {
+	return &funcExecutableRule{name: name, validate: validate}
+}
+
+type funcExecutableRule struct {
+	name     string
+	validate func(s *schema.Schema, doc *ast.ExecutableDocument, report ReportFunc)
+}
+
+func (r *funcExecutableRule) Name() string {
+	return r.name
+}
+
+func (r *funcExecutableRule) Validate(s *schema.Schema, doc *ast.ExecutableDocument, report ReportFunc) {
+	r.validate(s, doc, report)
+}
+
+// SpecifiedExecutableRules returns the validation rules of executable documents of the GraphQL specification,
+// in the order they run in ValidateExecutableDocument.
+//
+// The rule that a document contains only executable definitions is enforced by parser.ParseExecutableDocument,
+// as an ast.ExecutableDocument can not hold any other definition.
+func SpecifiedExecutableRules() []ExecutableRule {
+	return []ExecutableRule{
+		specifiedExecutableRule(RuleOperationNameUniqueness, (*validator).validateOperationNameUniqueness),
+		specifiedExecutableRule(RuleLoneAnonymousOperation, (*validator).validateLoneAnonymousOperation),
+		specifiedExecutableRule(RuleSubscriptionSingleRootField, (*validator).validateSubscriptionSingleRootField),
+		specifiedExecutableRule(RuleFieldSelections, (*validator).validateFieldSelections),
+		specifiedExecutableRule(RuleLeafFieldSelections, (*validator).validateLeafFieldSelections),
+		specifiedExecutableRule(RuleOverlappingFieldsCanBeMerged, (*validator).validateOverlappingFieldsCanBeMerged),
+		specifiedExecutableRule(RuleFragmentNameUniqueness, (*validator).validateFragmentNameUniqueness),
+		specifiedExecutableRule(RuleFragmentTypeConditions, (*validator).validateFragmentTypeConditions),
+		specifiedExecutableRule(RuleFragmentsMustBeUsed, (*validator).validateFragmentsMustBeUsed),
+		specifiedExecutableRule(RuleFragmentSpreadTargetDefined, (*validator).validateFragmentSpreadTargetDefined),
+		specifiedExecutableRule(RuleFragmentSpreadsMustNotFormCycles, (*validator).validateFragmentSpreadsMustNotFormCycles),
+		specifiedExecutableRule(RuleFragmentSpreadIsPossible, (*validator).validateFragmentSpreadIsPossible),
+		specifiedExecutableRule(RuleArgumentNames, (*validator).validateArgumentNames),
+		specifiedExecutableRule(RuleArgumentUniqueness, (*validator).validateArgumentUniqueness),
+		specifiedExecutableRule(RuleRequiredArguments, (*validator).validateRequiredArguments),
+		specifiedExecutableRule(RuleVariableUniqueness, (*validator).validateVariableUniqueness),
+		specifiedExecutableRule(RuleVariablesAreInputTypes, (*validator).validateVariablesAreInputTypes),
+		specifiedExecutableRule(RuleAllVariableUsesDefined, (*validator).validateAllVariableUsesDefined),
+		specifiedExecutableRule(RuleAllVariablesUsed, (*validator).validateAllVariablesUsed),
+		specifiedExecutableRule(RuleAllVariableUsagesAreAllowed, (*validator).validateAllVariableUsagesAreAllowed),
+	}
+}
+
+// specifiedExecutableRule returns an ExecutableRule which runs validate with a validator of the schema and the document.
+func specifiedExecutableRule(name string, validate func(v *validator)) ExecutableRule {
+	return NewExecutableRule(name, func(s *schema.Schema, doc *ast.ExecutableDocument, report ReportFunc) {
+		validate(&validator{schema: s, document: doc, report: report})
+	})
+}
@@ -0,0 +1,89 @@
+package validator
+
+import (
+	"errors"
+	"github.com/Sntree2mi8/gogqlparser/schema"
+	"github.com/google/go-cmp/cmp"
+	"strings"
+	"testing"
+)
+
+// payloadRule requires every mutation field to return a type named with the suffix "Payload".
+var payloadRule = NewRule("MutationPayloads", func(s *schema.Schema, report ReportFunc) {
+	mutation := s.MutationType()
+	if mutation == nil {
+		return
+	}
+	for _, f := range mutation.FieldDefinitions {
+		if !strings.HasSuffix(f.Type.NamedType, "Payload") {
+			report(mutation.Name+"."+f.Name, f.Position, "mutation field %s.%s must return a payload type", mutation.Name, f.Name)
+		}
+	}
+})
+
+func TestValidate(t *testing.T) {
+	doc := mustParse(t, `schema { query: Query mutation: Mutation }
+type Query { a: Int }
+type Mutation {
+  createUser: CreateUserPayload
+  deleteUser: Boolean
+  __updateUser: Boolean
+}
+type CreateUserPayload { id: ID }
+`)
+
+	type result struct {
+		Rule    string
+		Element string
+	}
+	tests := []struct {
+		name  string
+		rules []Rule
+		want  []result
+	}{
+		{
+			name:  "specified rules",
+			rules: SpecifiedRules(),
+			want: []result{
+				{Rule: RuleObjectTypes, Element: "Mutation.__updateUser"},
+			},
+		},
+		{
+			name:  "custom rule",
+			rules: []Rule{payloadRule},
+			want: []result{
+				{Rule: "MutationPayloads", Element: "Mutation.deleteUser"},
+				{Rule: "MutationPayloads", Element: "Mutation.__updateUser"},
+			},
+		},
+		{
+			name:  "specified and custom rules",
+			rules: append(SpecifiedRules(), payloadRule),
+			want: []result{
+				{Rule: RuleObjectTypes, Element: "Mutation.__updateUser"},
+				{Rule: "MutationPayloads", Element: "Mutation.deleteUser"},
+				{Rule: "MutationPayloads", Element: "Mutation.__updateUser"},
+			},
+		},
+		{
+			name: "no rules",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(doc, tt.rules...)
+
+			var errs Errors
+			if err != nil && !errors.As(err, &errs) {
+				t.Fatalf("Validate() error = %v, want Errors", err)
+			}
+			var got []result
+			for _, e := range errs {
+				got = append(got, result{Rule: e.Rule, Element: e.Element})
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Validate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+// The meta fields every query can select besides the fields its types define.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Schema-Introspection
+var (
+	typeNameMetaField = &ast.FieldDefinition{
+		Name: "__typename",
+		Type: ast.Type{NamedType: "String", NotNull: true},
+	}
+	schemaMetaField = &ast.FieldDefinition{
+		Name: "__schema",
+		Type: ast.Type{NamedType: "__Schema", NotNull: true},
+	}
+	typeMetaField = &ast.FieldDefinition{
+		Name: "__type",
+		ArgumentDefinition: []ast.InputValueDefinition{
+			{Name: "name", Type: ast.Type{NamedType: "String", NotNull: true}},
+		},
+		Type: ast.Type{NamedType: "__Type"},
+	}
+)
+
+// fieldDefinition returns the definition of the field named name selected on parent, or nil if parent has no such field.
+// __typename can be selected on every composite type and __schema and __type on the query root type.
+func (v *validator) fieldDefinition(parent ast.TypeDefinition, name string) *ast.FieldDefinition {
+	switch name {
+	case typeNameMetaField.Name:
+		return typeNameMetaField
+	case schemaMetaField.Name, typeMetaField.Name:
+		if query := v.schema.QueryType(); query == nil || parent.TypeName() != query.Name {
+			return nil
+		}
+		if name == schemaMetaField.Name {
+			return schemaMetaField
+		}
+		return typeMetaField
+	}
+
+	switch parent.TypeDefinitionKind() {
+	case ast.TypeDefinitionKindObject, ast.TypeDefinitionKindInterface:
+		return v.schema.Field(parent, name)
+	default:
+		return nil
+	}
+}
+
+// rootOperationType returns the root operation type of op, or nil if the schema does not define it.
+func (v *validator) rootOperationType(op *ast.OperationDefinition) *ast.ObjectTypeDefinition {
+	switch op.OperationType {
+	case ast.OperationTypeMutation:
+		return v.schema.MutationType()
+	case ast.OperationTypeSubscription:
+		return v.schema.SubscriptionType()
+	default:
+		return v.schema.QueryType()
+	}
+}
+
+// fragment returns the first fragment definition named name, or nil if there is none.
+func (v *validator) fragment(name string) *ast.FragmentDefinition {
+	if v.fragments == nil {
+		v.fragments = make(map[string]*ast.FragmentDefinition, len(v.document.FragmentDefinitions))
+		for _, fd := range v.document.FragmentDefinitions {
+			if _, ok := v.fragments[fd.Name]; !ok {
+				v.fragments[fd.Name] = fd
+			}
+		}
+	}
+	return v.fragments[name]
+}
+
+// compositeType returns the object, interface or union type named name, or nil if there is none.
+func (v *validator) compositeType(name string) ast.TypeDefinition {
+	td := v.schema.Type(name)
+	if td == nil {
+		return nil
+	}
+	switch td.TypeDefinitionKind() {
+	case ast.TypeDefinitionKindObject, ast.TypeDefinitionKindInterface, ast.TypeDefinitionKindUnion:
+		return td
+	default:
+		return nil
+	}
+}
+
+// walkSelectionSets calls visit with every selection set of the document and the type it selects on,
+// starting from the operations and the fragment definitions.
+// The selection sets of fields, fragments and operations whose type is unknown or not composite are skipped,
+// as there is nothing to validate them against.
+func (v *validator) walkSelectionSets(visit func(parent ast.TypeDefinition, set ast.SelectionSet)) {
+	var walk func(parent ast.TypeDefinition, set ast.SelectionSet)
+	walk = func(parent ast.TypeDefinition, set ast.SelectionSet) {
+		visit(parent, set)
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				fd := v.fieldDefinition(parent, sel.Name)
+				if fd == nil {
+					continue
+				}
+				if td := v.compositeType(getUnderlyingType(fd.Type).NamedType); td != nil {
+					walk(td, sel.SelectionSet)
+				}
+			case *ast.InlineFragment:
+				td := parent
+				if sel.TypeCondition != "" {
+					td = v.compositeType(sel.TypeCondition)
+				}
+				if td != nil {
+					walk(td, sel.SelectionSet)
+				}
+			}
+		}
+	}
+
+	for _, op := range v.document.OperationDefinitions {
+		if root := v.rootOperationType(op); root != nil {
+			walk(root, op.SelectionSet)
+		}
+	}
+	for _, fd := range v.document.FragmentDefinitions {
+		if td := v.compositeType(fd.TypeCondition); td != nil {
+			walk(td, fd.SelectionSet)
+		}
+	}
+}
+
+// collectFields returns the fields set selects on the object type obj, following fragment spreads and inline fragments
+// whose type applies to obj. Fields excluded by @skip or @include with a literal argument are left out.
+// visited holds the names of the fragments already followed.
+//
+// Reference: https://spec.graphql.org/October2021/#CollectFields()
+func (v *validator) collectFields(obj *ast.ObjectTypeDefinition, set ast.SelectionSet, visited map[string]bool) []*ast.Field {
+	var fields []*ast.Field
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if !isExcluded(sel.Directives) {
+				fields = append(fields, sel)
+			}
+		case *ast.FragmentSpread:
+			if visited[sel.FragmentName] || isExcluded(sel.Directives) {
+				continue
+			}
+			visited[sel.FragmentName] = true
+			fd := v.fragment(sel.FragmentName)
+			if fd == nil || !v.doesFragmentTypeApply(obj, fd.TypeCondition) {
+				continue
+			}
+			fields = append(fields, v.collectFields(obj, fd.SelectionSet, visited)...)
+		case *ast.InlineFragment:
+			if isExcluded(sel.Directives) || (sel.TypeCondition != "" && !v.doesFragmentTypeApply(obj, sel.TypeCondition)) {
+				continue
+			}
+			fields = append(fields, v.collectFields(obj, sel.SelectionSet, visited)...)
+		}
+	}
+	return fields
+}
+
+// Reference: https://spec.graphql.org/October2021/#DoesFragmentTypeApply()
+func (v *validator) doesFragmentTypeApply(obj *ast.ObjectTypeDefinition, typeCondition string) bool {
+	td := v.schema.Type(typeCondition)
+	if td == nil {
+		return false
+	}
+	for _, possible := range v.schema.PossibleTypes(td) {
+		if possible.Name == obj.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// isExcluded reports whether directives hold @skip(if: true) or @include(if: false).
+// A variable argument is not known before execution, so it never excludes.
+func isExcluded(directives []ast.Directive) bool {
+	for _, d := range directives {
+		if d.Name != "skip" && d.Name != "include" {
+			continue
+		}
+		for _, arg := range d.Arguments {
+			if b, ok := arg.Value.(ast.BooleanValue); ok && arg.Name == "if" && b.Value == (d.Name == "skip") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,250 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"slices"
+	"strings"
+)
+
+// https://spec.graphql.org/October2021/#sec-Names.Reserved-Names
+func (v *validator) validateTypeNames() {
+	for _, td := range v.schema.Document().TypeDefinitions {
+		if strings.HasPrefix(td.TypeName(), "__") {
+			v.report(td.TypeName(), td.GetPosition(), "type name must not begins with \"__\": %s", td.TypeName())
+		}
+	}
+}
+
+func (v *validator) isOutputType(t ast.Type) (bool, error) {
+	if t.ListType != nil {
+		return v.isOutputType(*t.ListType)
+	}
+
+	td := v.schema.Type(t.NamedType)
+	if td == nil {
+		return false, fmt.Errorf("undefined type: %s", t.NamedType)
+	}
+	switch td.TypeDefinitionKind() {
+	case ast.TypeDefinitionKindInputObject:
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+// validateFieldsDefinition validates the fields of an object or interface type.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Objects.Type-Validation
+func (v *validator) validateFieldsDefinition(td ast.TypeDefinition) {
+	typeName := td.TypeName()
+	fields, _ := fieldsAndInterfaces(td)
+	if len(fields) == 0 {
+		v.report(typeName, td.GetPosition(), "type %s must define one or more fields", typeName)
+		return
+	}
+
+	names := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		element := typeName + "." + f.Name
+		if names[f.Name] {
+			v.report(element, f.Position, "field name must be unique: %s.%s", typeName, f.Name)
+		}
+		names[f.Name] = true
+
+		if strings.HasPrefix(f.Name, "__") {
+			v.report(element, f.Position, "field name must not begins with \"__\": %s.%s", typeName, f.Name)
+		}
+
+		if outputType, err := v.isOutputType(f.Type); err != nil {
+			v.report(element, f.Position, "%s", err)
+		} else if !outputType {
+			v.report(element, f.Position, "field %s.%s must be output type (scalar, object, interface, union, enum)", typeName, f.Name)
+		}
+
+		argNames := make(map[string]bool, len(f.ArgumentDefinition))
+		for _, ad := range f.ArgumentDefinition {
+			argElement := fmt.Sprintf("%s.%s(%s:)", typeName, f.Name, ad.Name)
+			if argNames[ad.Name] {
+				v.report(argElement, ad.Position, "argument name must be unique: %s", argElement)
+			}
+			argNames[ad.Name] = true
+
+			if strings.HasPrefix(ad.Name, "__") {
+				v.report(argElement, ad.Position, "argument name must not begins with \"__\": %s", argElement)
+			}
+
+			if inputType, err := v.isInputType(ad.Type); err != nil {
+				v.report(argElement, ad.Position, "%s", err)
+			} else if !inputType {
+				v.report(argElement, ad.Position, "argument %s must be input type (scalar, enum, input object)", argElement)
+			}
+		}
+	}
+}
+
+// validateImplementsInterfaces validates the interfaces which an object or interface type implements.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Objects.Type-Validation
+func (v *validator) validateImplementsInterfaces(td ast.TypeDefinition) {
+	_, interfaces := fieldsAndInterfaces(td)
+
+	seen := make(map[string]bool, len(interfaces))
+	for _, name := range interfaces {
+		if seen[name] {
+			v.report(td.TypeName(), td.GetPosition(), "type %s must not implement %s twice", td.TypeName(), name)
+			continue
+		}
+		seen[name] = true
+
+		if name == td.TypeName() {
+			v.report(td.TypeName(), td.GetPosition(), "interface %s must not implement itself", name)
+			continue
+		}
+
+		implemented := v.schema.Type(name)
+		if implemented == nil {
+			v.report(td.TypeName(), td.GetPosition(), "undefined type: %s", name)
+			continue
+		}
+		iface, ok := implemented.(*ast.InterfaceTypeDefinition)
+		if !ok {
+			v.report(td.TypeName(), td.GetPosition(), "type %s must implement only interface types, %s is %s type", td.TypeName(), name, implemented.TypeDefinitionKind())
+			continue
+		}
+
+		v.validateImplementation(td, iface)
+	}
+}
+
+// validateImplementation reports why td is not a valid implementation of iface.
+//
+// Reference: https://spec.graphql.org/October2021/#IsValidImplementation()
+func (v *validator) validateImplementation(td ast.TypeDefinition, iface *ast.InterfaceTypeDefinition) {
+	_, interfaces := fieldsAndInterfaces(td)
+
+	for _, name := range iface.Interfaces {
+		if !slices.Contains(interfaces, name) {
+			v.report(td.TypeName(), td.GetPosition(), "type %s must implement %s because %s implements it", td.TypeName(), name, iface.Name)
+		}
+	}
+
+	for _, implementedField := range iface.FieldDefinitions {
+		field := v.schema.Field(td, implementedField.Name)
+		if field == nil {
+			v.report(td.TypeName(), td.GetPosition(), "type %s must define field %s of interface %s", td.TypeName(), implementedField.Name, iface.Name)
+			continue
+		}
+		element := td.TypeName() + "." + field.Name
+
+		for _, implementedArg := range implementedField.ArgumentDefinition {
+			arg := findArgument(field.ArgumentDefinition, implementedArg.Name)
+			if arg == nil {
+				v.report(element, field.Position, "field %s.%s must define argument %s of interface %s", td.TypeName(), field.Name, implementedArg.Name, iface.Name)
+				continue
+			}
+			if !equalType(arg.Type, implementedArg.Type) {
+				v.report(fmt.Sprintf("%s(%s:)", element, arg.Name), arg.Position, "argument %s.%s(%s:) must have type %s as in interface %s", td.TypeName(), field.Name, arg.Name, typeString(implementedArg.Type), iface.Name)
+			}
+		}
+		for _, arg := range field.ArgumentDefinition {
+			if findArgument(implementedField.ArgumentDefinition, arg.Name) == nil && isRequiredArgument(arg) {
+				v.report(fmt.Sprintf("%s(%s:)", element, arg.Name), arg.Position, "argument %s.%s(%s:) must not be required because interface %s does not define it", td.TypeName(), field.Name, arg.Name, iface.Name)
+			}
+		}
+
+		if !v.isValidImplementationFieldType(field.Type, implementedField.Type) {
+			v.report(element, field.Position, "field %s.%s must return %s or its sub-type as in interface %s", td.TypeName(), field.Name, typeString(implementedField.Type), iface.Name)
+		}
+	}
+}
+
+// isValidImplementationFieldType reports whether fieldType is equal to or a sub-type of implementedFieldType.
+//
+// Reference: https://spec.graphql.org/October2021/#IsValidImplementationFieldType()
+func (v *validator) isValidImplementationFieldType(fieldType, implementedFieldType ast.Type) bool {
+	if fieldType.NotNull {
+		fieldType.NotNull = false
+		implementedFieldType.NotNull = false
+		return v.isValidImplementationFieldType(fieldType, implementedFieldType)
+	}
+	if implementedFieldType.NotNull {
+		return false
+	}
+
+	if fieldType.ListType != nil || implementedFieldType.ListType != nil {
+		if fieldType.ListType == nil || implementedFieldType.ListType == nil {
+			return false
+		}
+		return v.isValidImplementationFieldType(*fieldType.ListType, *implementedFieldType.ListType)
+	}
+
+	if fieldType.NamedType == implementedFieldType.NamedType {
+		return true
+	}
+
+	switch implemented := v.schema.Type(implementedFieldType.NamedType).(type) {
+	case *ast.UnionTypeDefinition:
+		_, isObject := v.schema.Type(fieldType.NamedType).(*ast.ObjectTypeDefinition)
+		return isObject && slices.ContainsFunc(implemented.MemberTypes, func(t ast.Type) bool { return t.NamedType == fieldType.NamedType })
+	case *ast.InterfaceTypeDefinition:
+		sub := v.schema.Type(fieldType.NamedType)
+		if sub == nil {
+			return false
+		}
+		_, interfaces := fieldsAndInterfaces(sub)
+		return slices.Contains(interfaces, implemented.Name)
+	default:
+		return false
+	}
+}
+
+// fieldsAndInterfaces returns the fields and the implemented interfaces of an object or interface type.
+func fieldsAndInterfaces(td ast.TypeDefinition) ([]*ast.FieldDefinition, []string) {
+	switch td := td.(type) {
+	case *ast.ObjectTypeDefinition:
+		return td.FieldDefinitions, td.Interfaces
+	case *ast.InterfaceTypeDefinition:
+		return td.FieldDefinitions, td.Interfaces
+	default:
+		return nil, nil
+	}
+}
+
+func findArgument(args []ast.InputValueDefinition, name string) *ast.InputValueDefinition {
+	for i := range args {
+		if args[i].Name == name {
+			return &args[i]
+		}
+	}
+	return nil
+}
+
+// isRequiredArgument reports whether arg must be given, which is when it is non-null without a default value.
+func isRequiredArgument(arg ast.InputValueDefinition) bool {
+	return arg.Type.NotNull && arg.DefaultValue == nil && arg.RawDefaultValue == ""
+}
+
+func equalType(a, b ast.Type) bool {
+	if a.NotNull != b.NotNull {
+		return false
+	}
+	if a.ListType != nil || b.ListType != nil {
+		return a.ListType != nil && b.ListType != nil && equalType(*a.ListType, *b.ListType)
+	}
+	return a.NamedType == b.NamedType
+}
+
+// typeString returns t as written in SDL, e.g. "[String!]".
+func typeString(t ast.Type) string {
+	var s string
+	if t.ListType != nil {
+		s = "[" + typeString(*t.ListType) + "]"
+	} else {
+		s = t.NamedType
+	}
+	if t.NotNull {
+		s += "!"
+	}
+	return s
+}
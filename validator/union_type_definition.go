@@ -0,0 +1,39 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+func (v *validator) validateUnionTypes() {
+	for _, td := range v.schema.Document().TypeDefinitions {
+		if td, ok := td.(*ast.UnionTypeDefinition); ok {
+			v.validateUnionTypeDefinition(td)
+		}
+	}
+}
+
+// https://spec.graphql.org/October2021/#sec-Unions.Type-Validation
+func (v *validator) validateUnionTypeDefinition(td *ast.UnionTypeDefinition) {
+	if len(td.MemberTypes) == 0 {
+		v.report(td.Name, td.Position, "union %s must include one or more member types", td.Name)
+		return
+	}
+
+	members := make(map[string]bool, len(td.MemberTypes))
+	for _, member := range td.MemberTypes {
+		if members[member.NamedType] {
+			v.report(td.Name, member.Position, "member type of union %s must be unique: %s", td.Name, member.NamedType)
+			continue
+		}
+		members[member.NamedType] = true
+
+		memberDef := v.schema.Type(member.NamedType)
+		if memberDef == nil {
+			v.report(td.Name, member.Position, "undefined type: %s", member.NamedType)
+			continue
+		}
+		if memberDef.TypeDefinitionKind() != ast.TypeDefinitionKindObject {
+			v.report(td.Name, member.Position, "member type of union %s must be object type, %s is %s type", td.Name, member.NamedType, memberDef.TypeDefinitionKind())
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"testing"
+)
+
+func Test_validateUnionTypeDefinition(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		doc     *ast.TypeSystemExtensionDocument
+		wantErr bool
+	}{
+		{
+			name:   "valid union",
+			schema: `union Result = User | Group type User { id: ID } type Group { id: ID }`,
+		},
+		{
+			name: "The union must include one or more member types",
+			doc: &ast.TypeSystemExtensionDocument{
+				TypeDefinitions: []ast.TypeDefinition{
+					&ast.UnionTypeDefinition{Name: "Result"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "The member types must be object types",
+			schema:  `union Result = User | Node type User { id: ID } interface Node { id: ID }`,
+			wantErr: true,
+		},
+		{
+			name:    "The member types must be defined",
+			schema:  `union Result = User`,
+			wantErr: true,
+		},
+		{
+			name:    "The member types must be unique",
+			schema:  `union Result = User | User type User { id: ID }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := tt.doc
+			if doc == nil {
+				doc = mustParse(t, tt.schema)
+			}
+			errs, err := validateSchema(doc, (*validator).validateUnionTypes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateUnionTypes() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
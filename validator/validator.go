@@ -1,47 +1,94 @@
 package validator
 
 import (
+	"errors"
 	"fmt"
 	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/schema"
 )
 
+// BultinTypeSystemExtensionDocument defines the scalars and directives every schema has.
+//
+// Deprecated: use schema.BuiltinTypeSystemExtensionDocument, which schema.New adds to every schema.
+var BultinTypeSystemExtensionDocument = schema.BuiltinTypeSystemExtensionDocument
+
+// ValidateTypeSystemExtensionDocument validates doc against SpecifiedRules.
+// It returns Errors holding every violation, or nil if there is none.
 func ValidateTypeSystemExtensionDocument(doc *ast.TypeSystemExtensionDocument) error {
-	doc = doc.Merge(BultinTypeSystemExtensionDocument)
-	v, err := newValidator(doc)
+	return Validate(doc, SpecifiedRules()...)
+}
+
+// Validate builds a schema from doc and runs rules over it in order.
+// It returns Errors holding every violation, or nil if there is none.
+// Custom rules run along with the specified ones by passing both, e.g.
+//
+//	validator.Validate(doc, append(validator.SpecifiedRules(), myRule)...)
+//
+// The problems found while building the schema, such as a type defined twice, are reported with RuleSchema,
+// then the rules run over the schema built from the first definitions.
+func Validate(doc *ast.TypeSystemExtensionDocument, rules ...Rule) error {
+	var errs Errors
+	s, err := schema.New(doc)
 	if err != nil {
-		return err
+		var schemaErrs schema.Errors
+		if !errors.As(err, &schemaErrs) {
+			return err
+		}
+		for _, e := range schemaErrs {
+			errs = append(errs, &Error{Rule: RuleSchema, Message: e.Message, Element: e.Element, Position: e.Position})
+		}
 	}
 
-	return v.validateDirectiveDefinitions()
-}
+	for _, rule := range rules {
+		rule.Validate(s, errs.collect(rule.Name()))
+	}
 
-type validator struct {
-	doc *ast.TypeSystemExtensionDocument
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
 
-	typeDefs      map[string]ast.TypeDefinition
-	directiveDefs map[string]ast.DirectiveDefinition
+// ValidateExecutableDocument validates doc against s with SpecifiedExecutableRules.
+// s should be valid, e.g. built from a document ValidateTypeSystemExtensionDocument accepts.
+// It returns Errors holding every violation, or nil if there is none.
+func ValidateExecutableDocument(s *schema.Schema, doc *ast.ExecutableDocument) error {
+	return ValidateExecutable(s, doc, SpecifiedExecutableRules()...)
 }
 
-func newValidator(doc *ast.TypeSystemExtensionDocument) (*validator, error) {
-	typeDefs := make(map[string]ast.TypeDefinition, len(doc.TypeDefinitions))
-	for _, def := range doc.TypeDefinitions {
-		if _, ok := typeDefs[def.TypeName()]; ok {
-			return nil, fmt.Errorf("duplicate type definition: %s", def.TypeName())
-		}
-		typeDefs[def.TypeName()] = def
+// ValidateExecutable runs rules over doc against s in order.
+// It returns Errors holding every violation, or nil if there is none.
+func ValidateExecutable(s *schema.Schema, doc *ast.ExecutableDocument, rules ...ExecutableRule) error {
+	var errs Errors
+	for _, rule := range rules {
+		rule.Validate(s, doc, errs.collect(rule.Name()))
 	}
 
-	directiveDefs := make(map[string]ast.DirectiveDefinition, len(doc.DirectiveDefinitions))
-	for _, def := range doc.DirectiveDefinitions {
-		if _, ok := directiveDefs[def.Name]; ok {
-			return nil, fmt.Errorf("duplicate directive definition: %s", def.Name)
-		}
-		directiveDefs[def.Name] = def
+	if len(errs) > 0 {
+		return errs
 	}
+	return nil
+}
+
+// collect returns a ReportFunc which appends every violation of the rule named rule to e.
+func (e *Errors) collect(rule string) ReportFunc {
+	return func(element string, pos *ast.Position, format string, args ...any) {
+		*e = append(*e, &Error{
+			Rule:     rule,
+			Message:  fmt.Sprintf(format, args...),
+			Element:  element,
+			Position: pos,
+		})
+	}
+}
+
+// validator holds the schema being validated for the specified rules,
+// and the executable document for the specified executable rules.
+type validator struct {
+	schema   *schema.Schema
+	document *ast.ExecutableDocument
+	report   ReportFunc
 
-	return &validator{
-		doc:           doc,
-		typeDefs:      typeDefs,
-		directiveDefs: directiveDefs,
-	}, nil
+	// fragments indexes the fragment definitions of document by name, see fragment.
+	fragments map[string]*ast.FragmentDefinition
 }
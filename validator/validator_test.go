@@ -0,0 +1,206 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/parser"
+	"github.com/Sntree2mi8/gogqlparser/schema"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+// validateSchema builds a schema from doc and runs the validations over it, returning every violation.
+func validateSchema(doc *ast.TypeSystemExtensionDocument, validations ...func(v *validator)) (Errors, error) {
+	s, err := schema.New(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs Errors
+	v := &validator{
+		schema: s,
+		report: func(element string, pos *ast.Position, format string, args ...any) {
+			errs = append(errs, &Error{Message: fmt.Sprintf(format, args...), Element: element, Position: pos})
+		},
+	}
+	for _, validate := range validations {
+		validate(v)
+	}
+	return errs, nil
+}
+
+// validateExecutable runs the validations over the executable document query against a schema built from sdl,
+// returning the messages of every violation.
+func validateExecutable(t *testing.T, sdl, query string, validations ...func(v *validator)) []string {
+	t.Helper()
+	s, err := schema.New(mustParse(t, sdl))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := parser.ParseExecutableDocument(&ast.Source{Name: "query.graphql", Body: query})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var messages []string
+	v := &validator{
+		schema:   s,
+		document: doc,
+		report: func(element string, pos *ast.Position, format string, args ...any) {
+			messages = append(messages, fmt.Sprintf(format, args...))
+		},
+	}
+	for _, validate := range validations {
+		validate(v)
+	}
+	return messages
+}
+
+func TestValidateTypeSystemExtensionDocument_Errors(t *testing.T) {
+	type result struct {
+		Rule     string
+		Element  string
+		Position string
+	}
+
+	tests := []struct {
+		name   string
+		schema string
+		want   []result
+	}{
+		{
+			name: "every violation is reported",
+			schema: `type Query {
+  a: Filter
+  __b: Int
+}
+enum Role { ADMIN ADMIN }
+input Filter { role: Role @undefined }
+directive @a(arg: Query) on FIELD_DEFINITION
+`,
+			want: []result{
+				{Rule: RuleDirectiveDefinitions, Element: "@a(arg:)", Position: "schema.graphql:7:14"},
+				{Rule: RuleObjectTypes, Element: "Query.a", Position: "schema.graphql:2:3"},
+				{Rule: RuleObjectTypes, Element: "Query.__b", Position: "schema.graphql:3:3"},
+				{Rule: RuleEnumTypes, Element: "Role.ADMIN", Position: "schema.graphql:5:19"},
+				{Rule: RuleDirectiveUsages, Element: "Filter.role", Position: "schema.graphql:6:27"},
+			},
+		},
+		{
+			name: "schema errors along with the violations of rules",
+			schema: `type Query { a: Int }
+scalar Query
+extend type Missing { a: Int }
+enum Role { ADMIN ADMIN }
+`,
+			want: []result{
+				{Rule: RuleSchema, Element: "Missing", Position: "schema.graphql:3:1"},
+				{Rule: RuleSchema, Element: "Query", Position: "schema.graphql:2:1"},
+				{Rule: RuleEnumTypes, Element: "Role.ADMIN", Position: "schema.graphql:4:19"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTypeSystemExtensionDocument(mustParse(t, tt.schema))
+
+			var errs Errors
+			if !errors.As(err, &errs) {
+				t.Fatalf("ValidateTypeSystemExtensionDocument() error = %v, want Errors", err)
+			}
+			var got []result
+			for _, e := range errs {
+				got = append(got, result{Rule: e.Rule, Element: e.Element, Position: e.Position.String()})
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ValidateTypeSystemExtensionDocument() mismatch (-want +got):\n%s\n%v", diff, err)
+			}
+		})
+	}
+}
+
+func TestValidateTypeSystemExtensionDocument_Valid(t *testing.T) {
+	err := ValidateTypeSystemExtensionDocument(mustParse(t, `type Query { a(role: Role = ADMIN): Int @deprecated }
+enum Role { ADMIN USER }
+`))
+	if err != nil {
+		t.Errorf("ValidateTypeSystemExtensionDocument() error = %v, want nil", err)
+	}
+}
+
+func TestValidateExecutableDocument(t *testing.T) {
+	s, err := schema.New(mustParse(t, `type Query { user(id: ID!): User }
+type User { id: ID! name: String friends: [User!]! }
+type Subscription { userAdded: User! }
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type result struct {
+		Rule     string
+		Element  string
+		Position string
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []result
+	}{
+		{
+			name: "every violation is reported",
+			query: `query A { user(id: 1) { name { first } } }
+query A { user(id: 2) }
+{ users }
+subscription { userAdded { id } __typename }
+`,
+			want: []result{
+				{Rule: RuleOperationNameUniqueness, Element: "A", Position: "query.graphql:2:1"},
+				{Rule: RuleLoneAnonymousOperation, Element: "query", Position: "query.graphql:3:1"},
+				{Rule: RuleLoneAnonymousOperation, Element: "subscription", Position: "query.graphql:4:1"},
+				{Rule: RuleSubscriptionSingleRootField, Element: "subscription", Position: "query.graphql:4:33"},
+				{Rule: RuleSubscriptionSingleRootField, Element: "subscription", Position: "query.graphql:4:33"},
+				{Rule: RuleFieldSelections, Element: "Query.users", Position: "query.graphql:3:3"},
+				{Rule: RuleLeafFieldSelections, Element: "User.name", Position: "query.graphql:1:25"},
+				{Rule: RuleLeafFieldSelections, Element: "Query.user", Position: "query.graphql:2:11"},
+			},
+		},
+		{
+			name: "valid document",
+			query: `query A { user(id: 1) { ...UserFields friends { __typename } } __schema { queryType { name } } }
+subscription B { userAdded { id } }
+fragment UserFields on User { id name }
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.ParseExecutableDocument(&ast.Source{Name: "query.graphql", Body: tt.query})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = ValidateExecutableDocument(s, doc)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("ValidateExecutableDocument() error = %v", err)
+				}
+				return
+			}
+
+			var errs Errors
+			if !errors.As(err, &errs) {
+				t.Fatalf("ValidateExecutableDocument() error = %v, want Errors", err)
+			}
+			var got []result
+			for _, e := range errs {
+				got = append(got, result{Rule: e.Rule, Element: e.Element, Position: e.Position.String()})
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ValidateExecutableDocument() mismatch (-want +got):\n%s\n%v", diff, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/Sntree2mi8/gogqlparser/ast"
+	"github.com/Sntree2mi8/gogqlparser/printer"
+	"math"
+	"slices"
+)
+
+// validateValue reports why the constant value can not be coerced to t.
+// Values of custom scalars are not checked, their coercion is up to the service.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Values-of-Correct-Type
+func (v *validator) validateValue(value ast.Value, t ast.Type) error {
+	if _, ok := value.(ast.NullValue); ok {
+		if t.NotNull {
+			return fmt.Errorf("expected value of type %s, found null", typeString(t))
+		}
+		return nil
+	}
+	if _, ok := value.(ast.Variable); ok {
+		return fmt.Errorf("expected constant value of type %s, found %s", typeString(t), printer.FormatValue(value))
+	}
+
+	if t.ListType != nil {
+		list, ok := value.(ast.ListValue)
+		if !ok {
+			// a single item is coerced to a list of one item.
+			return v.validateValue(value, *t.ListType)
+		}
+		for _, item := range list.Values {
+			if err := v.validateValue(item, *t.ListType); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	mismatch := fmt.Errorf("expected value of type %s, found %s", typeString(t), printer.FormatValue(value))
+
+	switch td := v.schema.Type(t.NamedType).(type) {
+	case *ast.ScalarTypeDefinition:
+		if !isBuiltinScalarValue(td.Name, value) {
+			return mismatch
+		}
+	case *ast.EnumTypeDefinition:
+		ev, ok := value.(ast.EnumValue)
+		if !ok {
+			return mismatch
+		}
+		if !slices.ContainsFunc(td.EnumValue, func(d ast.EnumValueDefinition) bool { return d.Value.Value == ev.Value }) {
+			return fmt.Errorf("value %s does not exist in enum %s", ev.Value, td.Name)
+		}
+	case *ast.InputObjectTypeDefinition:
+		obj, ok := value.(ast.ObjectValue)
+		if !ok {
+			return mismatch
+		}
+		return v.validateObjectValue(obj, td)
+	case nil:
+		return fmt.Errorf("undefined type: %s", t.NamedType)
+	default:
+		return fmt.Errorf("type %s of value %s must be input type", t.NamedType, printer.FormatValue(value))
+	}
+
+	return nil
+}
+
+// https://spec.graphql.org/October2021/#sec-Input-Object-Field-Names
+func (v *validator) validateObjectValue(obj ast.ObjectValue, td *ast.InputObjectTypeDefinition) error {
+	given := make(map[string]bool, len(obj.Fields))
+	for _, f := range obj.Fields {
+		if given[f.Name] {
+			return fmt.Errorf("input field %s.%s must be given only once", td.Name, f.Name)
+		}
+		given[f.Name] = true
+
+		def := findArgument(td.InputFields, f.Name)
+		if def == nil {
+			return fmt.Errorf("input field %s.%s is not defined", td.Name, f.Name)
+		}
+		if err := v.validateValue(f.Value, def.Type); err != nil {
+			return fmt.Errorf("input field %s.%s: %w", td.Name, f.Name, err)
+		}
+	}
+
+	for _, def := range td.InputFields {
+		if !given[def.Name] && isRequiredArgument(def) {
+			return fmt.Errorf("input field %s.%s of type %s is required", td.Name, def.Name, typeString(def.Type))
+		}
+	}
+
+	return nil
+}
+
+// isBuiltinScalarValue reports whether value can be coerced to the scalar named name.
+// It reports true for custom scalars.
+//
+// Reference: https://spec.graphql.org/October2021/#sec-Scalars.Built-in-Scalars
+func isBuiltinScalarValue(name string, value ast.Value) bool {
+	switch name {
+	case "Int":
+		i, ok := value.(ast.IntValue)
+		return ok && i.Value >= math.MinInt32 && i.Value <= math.MaxInt32
+	case "Float":
+		switch value.(type) {
+		case ast.IntValue, ast.FloatValue:
+			return true
+		}
+		return false
+	case "String":
+		_, ok := value.(ast.StringValue)
+		return ok
+	case "Boolean":
+		_, ok := value.(ast.BooleanValue)
+		return ok
+	case "ID":
+		switch value.(type) {
+		case ast.StringValue, ast.IntValue:
+			return true
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+// valuePosition returns the position of value, which is nil for a value built without one.
+func valuePosition(value ast.Value) *ast.Position {
+	switch value := value.(type) {
+	case ast.Variable:
+		return value.Position
+	case ast.IntValue:
+		return value.Position
+	case ast.FloatValue:
+		return value.Position
+	case ast.StringValue:
+		return value.Position
+	case ast.BooleanValue:
+		return value.Position
+	case ast.NullValue:
+		return value.Position
+	case ast.EnumValue:
+		return value.Position
+	case ast.ListValue:
+		return value.Position
+	case ast.ObjectValue:
+		return value.Position
+	default:
+		return nil
+	}
+}
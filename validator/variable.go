@@ -0,0 +1,247 @@
+package validator
+
+import (
+	"github.com/Sntree2mi8/gogqlparser/ast"
+)
+
+// variableUsage is a variable used in an operation, directly or through its fragments.
+type variableUsage struct {
+	variable ast.Variable
+	// location is the type of the argument, input field or list item the variable is given as, nil if it is unknown.
+	location *ast.Type
+	// hasLocationDefault reports whether the argument or input field has a default value.
+	hasLocationDefault bool
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-Validation.Variables
+func (v *validator) validateVariableUniqueness() {
+	for _, op := range v.document.OperationDefinitions {
+		defined := make(map[string]bool, len(op.VariableDefinitions))
+		for _, vd := range op.VariableDefinitions {
+			if defined[vd.Variable] {
+				v.report(operationElement(op), vd.Position, "variable $%s of %s must be defined only once", vd.Variable, describeOperation(op))
+			}
+			defined[vd.Variable] = true
+		}
+	}
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-Variables-Are-Input-Types
+func (v *validator) validateVariablesAreInputTypes() {
+	for _, op := range v.document.OperationDefinitions {
+		for _, vd := range op.VariableDefinitions {
+			isInput, err := v.isInputType(vd.Type)
+			if err != nil {
+				v.report(operationElement(op), vd.Position, "variable $%s of %s: %s", vd.Variable, describeOperation(op), err)
+				continue
+			}
+			if !isInput {
+				named := getUnderlyingType(vd.Type).NamedType
+				v.report(operationElement(op), vd.Position, "variable $%s of %s must be input type, %s is %s type", vd.Variable, describeOperation(op), named, v.schema.Type(named).TypeDefinitionKind())
+			}
+		}
+	}
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-All-Variable-Uses-Defined
+func (v *validator) validateAllVariableUsesDefined() {
+	for _, op := range v.document.OperationDefinitions {
+		for _, u := range v.variableUsages(op) {
+			if findVariableDefinition(op, u.variable.Name) == nil {
+				v.report(operationElement(op), u.variable.Position, "variable $%s is not defined by %s", u.variable.Name, describeOperation(op))
+			}
+		}
+	}
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-All-Variables-Used
+func (v *validator) validateAllVariablesUsed() {
+	for _, op := range v.document.OperationDefinitions {
+		used := make(map[string]bool)
+		for _, u := range v.variableUsages(op) {
+			used[u.variable.Name] = true
+		}
+		for _, vd := range op.VariableDefinitions {
+			if !used[vd.Variable] {
+				v.report(operationElement(op), vd.Position, "variable $%s of %s is never used", vd.Variable, describeOperation(op))
+			}
+		}
+	}
+}
+
+// Reference: https://spec.graphql.org/October2021/#sec-All-Variable-Usages-are-Allowed
+func (v *validator) validateAllVariableUsagesAreAllowed() {
+	for _, op := range v.document.OperationDefinitions {
+		for _, u := range v.variableUsages(op) {
+			vd := findVariableDefinition(op, u.variable.Name)
+			if vd == nil || u.location == nil {
+				continue
+			}
+			if !isVariableUsageAllowed(vd, u) {
+				v.report(operationElement(op), u.variable.Position, "variable $%s of type %s can not be used in position expecting %s", vd.Variable, typeString(vd.Type), typeString(*u.location))
+			}
+		}
+	}
+}
+
+// isVariableUsageAllowed reports whether the variable defined by vd can be used as u.
+// A nullable variable can be given to a non-null location if either of them has a default value.
+//
+// Reference: https://spec.graphql.org/October2021/#IsVariableUsageAllowed()
+func isVariableUsageAllowed(vd *ast.VariableDefinition, u variableUsage) bool {
+	location := *u.location
+	if location.NotNull && !vd.Type.NotNull {
+		_, isNull := vd.DefaultValue.(ast.NullValue)
+		hasNonNullDefault := vd.DefaultValue != nil && !isNull
+		if !hasNonNullDefault && !u.hasLocationDefault {
+			return false
+		}
+		location.NotNull = false
+	}
+	return areTypesCompatible(vd.Type, location)
+}
+
+// Reference: https://spec.graphql.org/October2021/#AreTypesCompatible()
+func areTypesCompatible(variable, location ast.Type) bool {
+	if location.NotNull {
+		if !variable.NotNull {
+			return false
+		}
+		variable.NotNull, location.NotNull = false, false
+		return areTypesCompatible(variable, location)
+	}
+	if variable.NotNull {
+		variable.NotNull = false
+		return areTypesCompatible(variable, location)
+	}
+	if location.ListType != nil {
+		return variable.ListType != nil && areTypesCompatible(*variable.ListType, *location.ListType)
+	}
+	if variable.ListType != nil {
+		return false
+	}
+	return variable.NamedType == location.NamedType
+}
+
+func findVariableDefinition(op *ast.OperationDefinition, name string) *ast.VariableDefinition {
+	for i := range op.VariableDefinitions {
+		if op.VariableDefinitions[i].Variable == name {
+			return &op.VariableDefinitions[i]
+		}
+	}
+	return nil
+}
+
+// variableUsages returns the variables used in the arguments of op, following its fragment spreads.
+// Each fragment is followed once, so a variable used in a fragment spread twice is returned once.
+func (v *validator) variableUsages(op *ast.OperationDefinition) []variableUsage {
+	c := &variableCollector{validator: v, spread: make(map[string]bool)}
+	c.directives(op.Directives)
+
+	var root ast.TypeDefinition
+	if td := v.rootOperationType(op); td != nil {
+		root = td
+	}
+	c.selectionSet(root, op.SelectionSet)
+	return c.usages
+}
+
+// variableCollector collects the variables used in a selection set and the fragments it spreads.
+type variableCollector struct {
+	*validator
+	spread map[string]bool
+	usages []variableUsage
+}
+
+// selectionSet collects the variables of set, which selects on parent, or nil if the type is unknown.
+// Variables of unknown fields and arguments are collected without their location.
+func (c *variableCollector) selectionSet(parent ast.TypeDefinition, set ast.SelectionSet) {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			var fd *ast.FieldDefinition
+			if parent != nil {
+				fd = c.fieldDefinition(parent, sel.Name)
+			}
+
+			var (
+				defs  []ast.InputValueDefinition
+				child ast.TypeDefinition
+			)
+			if fd != nil {
+				defs = fd.ArgumentDefinition
+				child = c.compositeType(getUnderlyingType(fd.Type).NamedType)
+			}
+			c.arguments(sel.Arguments, defs)
+			c.directives(sel.Directives)
+			c.selectionSet(child, sel.SelectionSet)
+		case *ast.FragmentSpread:
+			c.directives(sel.Directives)
+			if c.spread[sel.FragmentName] {
+				continue
+			}
+			c.spread[sel.FragmentName] = true
+			if fd := c.fragment(sel.FragmentName); fd != nil {
+				c.directives(fd.Directives)
+				c.selectionSet(c.compositeType(fd.TypeCondition), fd.SelectionSet)
+			}
+		case *ast.InlineFragment:
+			c.directives(sel.Directives)
+			td := parent
+			if sel.TypeCondition != "" {
+				td = c.compositeType(sel.TypeCondition)
+			}
+			c.selectionSet(td, sel.SelectionSet)
+		}
+	}
+}
+
+func (c *variableCollector) directives(directives []ast.Directive) {
+	for _, d := range directives {
+		var defs []ast.InputValueDefinition
+		if dd := c.schema.Directive(d.Name); dd != nil {
+			defs = dd.ArgumentsDefinition
+		}
+		c.arguments(d.Arguments, defs)
+	}
+}
+
+func (c *variableCollector) arguments(args []ast.Argument, defs []ast.InputValueDefinition) {
+	for _, arg := range args {
+		if ad := findArgument(defs, arg.Name); ad != nil {
+			c.value(arg.Value, &ad.Type, ad.DefaultValue != nil || ad.RawDefaultValue != "")
+		} else {
+			c.value(arg.Value, nil, false)
+		}
+	}
+}
+
+// value collects the variables of value, which is given at a location of type t, nil if it is unknown.
+func (c *variableCollector) value(value ast.Value, t *ast.Type, hasDefault bool) {
+	switch value := value.(type) {
+	case ast.Variable:
+		c.usages = append(c.usages, variableUsage{variable: value, location: t, hasLocationDefault: hasDefault})
+	case ast.ListValue:
+		var item *ast.Type
+		if t != nil {
+			item = t.ListType
+		}
+		for _, v := range value.Values {
+			c.value(v, item, false)
+		}
+	case ast.ObjectValue:
+		var fields []ast.InputValueDefinition
+		if t != nil && t.ListType == nil {
+			if td, ok := c.schema.Type(t.NamedType).(*ast.InputObjectTypeDefinition); ok {
+				fields = td.InputFields
+			}
+		}
+		for _, f := range value.Fields {
+			if fd := findArgument(fields, f.Name); fd != nil {
+				c.value(f.Value, &fd.Type, fd.DefaultValue != nil || fd.RawDefaultValue != "")
+			} else {
+				c.value(f.Value, nil, false)
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package validator
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+const variableTestSchema = `
+type Query { dog(id: ID!): Dog dogs(ids: [ID!], filter: DogFilter): [Dog!]! }
+input DogFilter { name: String! size: Int = 1 tags: [String!]! }
+type Dog { name(format: String): String isHouseTrained(atOtherHomes: Boolean! = true): Boolean! }
+`
+
+func Test_validateVariables(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "valid variables",
+			query: `query Q($id: ID!, $format: String, $skip: Boolean!) { dog(id: $id) { ...F @skip(if: $skip) } } fragment F on Dog { name(format: $format) }`,
+		},
+		{
+			name:  "variables must be unique",
+			query: `query Q($id: ID!, $id: ID!) { dog(id: $id) { name } }`,
+			want:  []string{"variable $id of query Q must be defined only once"},
+		},
+		{
+			name:  "variables must be input types",
+			query: `query Q($dog: Dog, $cat: [Cat]) { dogs { name } }`,
+			want: []string{
+				"variable $dog of query Q must be input type, Dog is object type",
+				"variable $cat of query Q: undefined type: Cat",
+				"variable $dog of query Q is never used",
+				"variable $cat of query Q is never used",
+			},
+		},
+		{
+			name:  "variable uses must be defined by every operation",
+			query: `query A($id: ID!) { dog(id: $id) { ...F } } query B { dog(id: $id) { ...F } } fragment F on Dog { name(format: $format) }`,
+			want: []string{
+				"variable $format is not defined by query A",
+				"variable $id is not defined by query B",
+				"variable $format is not defined by query B",
+			},
+		},
+		{
+			name:  "variables used in unknown fields and list and object values count",
+			query: `query Q($a: String, $b: ID!, $c: String!) { unknown(arg: $a) dogs(ids: [$b], filter: {name: $c, tags: []}) { name } }`,
+		},
+		{
+			name:  "variables must be used",
+			query: `query Q($id: ID!, $unused: String) { dog(id: $id) { name } }`,
+			want:  []string{"variable $unused of query Q is never used"},
+		},
+		{
+			name:  "variable usages must be allowed in their position",
+			query: `query Q($id: ID, $ids: [ID], $format: Int, $name: String) { dog(id: $id) { name(format: $format) } dogs(ids: $ids, filter: {name: $name, tags: [$name]}) { name } }`,
+			want: []string{
+				"variable $id of type ID can not be used in position expecting ID!",
+				"variable $format of type Int can not be used in position expecting String",
+				"variable $ids of type [ID] can not be used in position expecting [ID!]",
+				"variable $name of type String can not be used in position expecting String!",
+				"variable $name of type String can not be used in position expecting String!",
+			},
+		},
+		{
+			name:  "a nullable variable is allowed in a non-null position with a default value",
+			query: `query Q($id: ID = "1", $trained: Boolean, $size: Int) { dog(id: $id) { isHouseTrained(atOtherHomes: $trained) } dogs(filter: {name: "a", size: $size, tags: []}) { name } }`,
+		},
+		{
+			name:  "a null default value does not allow a nullable variable in a non-null position",
+			query: `query Q($id: ID = null) { dog(id: $id) { name } }`,
+			want:  []string{"variable $id of type ID can not be used in position expecting ID!"},
+		},
+		{
+			name:  "a non-null variable is allowed in a nullable position",
+			query: `query Q($format: String!, $id: ID!) { dog(id: $id) { name(format: $format) } dogs(ids: [$id]) { name } }`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateExecutable(t, variableTestSchema, tt.query,
+				(*validator).validateVariableUniqueness,
+				(*validator).validateVariablesAreInputTypes,
+				(*validator).validateAllVariableUsesDefined,
+				(*validator).validateAllVariablesUsed,
+				(*validator).validateAllVariableUsagesAreAllowed,
+			)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validate variables mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}